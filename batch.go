@@ -0,0 +1,90 @@
+package go_fifa
+
+import (
+	"context"
+	"sync"
+)
+
+const defaultBatchWorkers = 4
+
+type BatchOptions struct {
+	// Workers is the number of requests in flight at once.
+	Workers int
+}
+
+type MatchDataResult struct {
+	Ref  MatchRef
+	Data *MatchDataResponse
+	Err  error
+}
+
+type MatchEventsResult struct {
+	Ref    MatchRef
+	Events *GetMatchEventsResponse
+	Err    error
+}
+
+// runBatch calls fn for every index in [0, n) using a pool of workers. Once
+// ctx is done, remaining items are passed to skip instead.
+func runBatch(ctx context.Context, n int, opts *BatchOptions, fn func(i int), skip func(i int, err error)) {
+	workers := defaultBatchWorkers
+	if opts != nil && opts.Workers > 0 {
+		workers = opts.Workers
+	}
+	if workers > n {
+		workers = n
+	}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := ctx.Err(); err != nil {
+					skip(i, err)
+					continue
+				}
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// GetMatchDataBatch fetches the data of many matches concurrently. Results are
+// returned in the order of the input, each with its own error, so one failing
+// match does not fail the batch. Requests go through the client's rate limiter
+// and circuit breaker, and stop being issued once ctx is cancelled.
+func (c *Client) GetMatchDataBatch(ctx context.Context, matches []MatchRef, opts *BatchOptions) []MatchDataResult {
+	results := make([]MatchDataResult, len(matches))
+	runBatch(ctx, len(matches), opts, func(i int) {
+		results[i].Ref = matches[i]
+		data, err := c.getMatchData(ctx, &matches[i])
+		if err != nil {
+			results[i].Err = err
+			return
+		}
+		results[i].Data = &data
+	}, func(i int, err error) {
+		results[i] = MatchDataResult{Ref: matches[i], Err: err}
+	})
+	return results
+}
+
+// GetMatchEventsBatch fetches the timelines of many matches concurrently, with
+// the same ordering and error semantics as GetMatchDataBatch.
+func (c *Client) GetMatchEventsBatch(ctx context.Context, matches []MatchRef, opts *BatchOptions) []MatchEventsResult {
+	results := make([]MatchEventsResult, len(matches))
+	runBatch(ctx, len(matches), opts, func(i int) {
+		results[i].Ref = matches[i]
+		results[i].Events, results[i].Err = c.getMatchEvents(ctx, &matches[i])
+	}, func(i int, err error) {
+		results[i] = MatchEventsResult{Ref: matches[i], Err: err}
+	})
+	return results
+}
@@ -0,0 +1,105 @@
+package go_fifa_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingLimiter struct {
+	calls int32
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	atomic.AddInt32(&l.calls, 1)
+	return ctx.Err()
+}
+
+// unlockedHTTPClient answers every request without any locking of its own, so
+// the race detector sees any shared state the client writes per request.
+type unlockedHTTPClient func(req *http.Request) (*http.Response, error)
+
+func (f unlockedHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestGetMatchEventsBatchDefaultsRace(t *testing.T) {
+	t.Parallel()
+	var defaulted int32
+	stub := unlockedHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host == "api.fifa.com" && req.Header.Get("User-Agent") != "" && req.Header.Get("Accept-Language") != "" {
+			atomic.AddInt32(&defaulted, 1)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader(`{}`)), Request: req}, nil
+	})
+	var refs []fifa.MatchRef
+	for i := 0; i < 20; i++ {
+		refs = append(refs, fifa.MatchRef{CompetitionId: "17", SeasonId: "s", StageId: "g", MatchId: fmt.Sprintf("m%d", i)})
+	}
+	client := fifa.Client{Client: stub}
+	results := client.GetMatchEventsBatch(context.Background(), refs, &fifa.BatchOptions{Workers: 5})
+	for _, r := range results {
+		assert.Nil(t, r.Err)
+	}
+	assert.Equal(t, int32(20), atomic.LoadInt32(&defaulted), "every request should get the default base URL and headers")
+	assert.Equal(t, "", client.ApiBaseURL, "defaults should not be written back to the client")
+}
+
+func TestGetMatchEventsBatch(t *testing.T) {
+	t.Parallel()
+	responses := map[string]stubResponse{}
+	var refs []fifa.MatchRef
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("m%d", i)
+		refs = append(refs, fifa.MatchRef{CompetitionId: "17", SeasonId: "s", StageId: "g", MatchId: id})
+		if i != 7 {
+			responses["/timelines/17/s/g/"+id] = stubResponse{Body: fmt.Sprintf(`{"IdMatch":%q}`, id)}
+		}
+	}
+	limiter := &countingLimiter{}
+	client := fifa.Client{Client: &stubHTTPClient{responses: responses}, Limiter: limiter}
+	results := client.GetMatchEventsBatch(context.Background(), refs, &fifa.BatchOptions{Workers: 5})
+	if ok := assert.Len(t, results, 20); !ok {
+		t.FailNow()
+	}
+	for i, r := range results {
+		assert.Equal(t, refs[i], r.Ref)
+		if i == 7 {
+			var apiErr *fifa.APIError
+			assert.True(t, errors.As(r.Err, &apiErr), "expected per-item error, got: %v", r.Err)
+			continue
+		}
+		if ok := assert.Nil(t, r.Err); ok {
+			assert.Equal(t, refs[i].MatchId, r.Events.MatchId, "results should keep input order")
+		}
+	}
+	assert.Equal(t, int32(20), atomic.LoadInt32(&limiter.calls), "every request should wait on the limiter")
+}
+
+func TestGetMatchDataBatchCancelled(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{}
+	client := fifa.Client{Client: stub}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	refs := []fifa.MatchRef{
+		{CompetitionId: "17", SeasonId: "s", StageId: "g", MatchId: "a"},
+		{CompetitionId: "17", SeasonId: "s", StageId: "g", MatchId: "b"},
+		{CompetitionId: "17", SeasonId: "s", StageId: "g", MatchId: "c"},
+	}
+	results := client.GetMatchDataBatch(ctx, refs, nil)
+	for i, r := range results {
+		assert.Equal(t, refs[i], r.Ref)
+		assert.True(t, errors.Is(r.Err, context.Canceled), "expected context.Canceled, got: %v", r.Err)
+		assert.Nil(t, r.Data)
+	}
+	assert.Equal(t, 0, stub.requestCount())
+}
@@ -0,0 +1,373 @@
+package go_fifa
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// BracketTie is a knockout tie of one or two legs. Home and away refer to the
+// first leg.
+type BracketTie struct {
+	StageId   string
+	StageName string
+	Round     int
+	Legs      []MatchResponse
+	// HomeTeamId and AwayTeamId are empty until the slot is decided, in which
+	// case the placeholder (e.g. "1A" or "W49") describes the team to come.
+	HomeTeamId       string
+	AwayTeamId       string
+	HomePlaceholder  string
+	AwayPlaceholder  string
+	HomeScore        int
+	AwayScore        int
+	HomePenaltyScore int
+	AwayPenaltyScore int
+	ExtraTime        bool
+	WinnerId         string
+	// HomeFeeder and AwayFeeder are the ties whose winner (or loser, for a
+	// third place play-off) fills each slot.
+	HomeFeeder *BracketTie
+	AwayFeeder *BracketTie
+	// Next is the tie the winner goes on to.
+	Next       *BracketTie
+	ThirdPlace bool
+}
+
+func (t *BracketTie) TwoLegged() bool {
+	return len(t.Legs) > 1
+}
+
+func (t *BracketTie) Decided() bool {
+	return t.WinnerId != ""
+}
+
+func (t *BracketTie) Penalties() bool {
+	return t.HomePenaltyScore > 0 || t.AwayPenaltyScore > 0
+}
+
+func (t *BracketTie) LoserId() string {
+	switch t.WinnerId {
+	case "":
+		return ""
+	case t.HomeTeamId:
+		return t.AwayTeamId
+	}
+	return t.HomeTeamId
+}
+
+func (t *BracketTie) hasTeam(teamId string) bool {
+	return teamId != "" && (t.HomeTeamId == teamId || t.AwayTeamId == teamId)
+}
+
+func (t *BracketTie) hasMatchNumber(n int) bool {
+	for _, m := range t.Legs {
+		if m.MatchNumber == n {
+			return true
+		}
+	}
+	return false
+}
+
+type BracketRound struct {
+	StageId string
+	Name    string
+	Ties    []*BracketTie
+}
+
+type Bracket struct {
+	Rounds []*BracketRound
+}
+
+// NewBracket builds the knockout bracket of a season from its matches and
+// stages. Stages are classified with StageResponse.Type and ordered by
+// SequenceOrder; when no stages are given they are derived from the matches.
+// Legs of the same stage between the same two teams form a single tie.
+func NewBracket(matches []MatchResponse, stages []StageResponse) *Bracket {
+	if len(stages) == 0 {
+		stages = stagesFromMatches(matches)
+	}
+	var knockout []StageResponse
+	for _, s := range stages {
+		if s.Type() == KnockoutStage {
+			knockout = append(knockout, s)
+		}
+	}
+	sort.SliceStable(knockout, func(i, j int) bool {
+		return knockout[i].SequenceOrder < knockout[j].SequenceOrder
+	})
+
+	b := &Bracket{}
+	for _, s := range knockout {
+		round := &BracketRound{StageId: s.Id}
+		if len(s.Name) > 0 {
+			round.Name = s.Name[0].Description
+		}
+		var legs []MatchResponse
+		for _, m := range matches {
+			if m.StageId == s.Id {
+				legs = append(legs, m)
+			}
+		}
+		sort.SliceStable(legs, func(i, j int) bool {
+			return legs[i].Date.Before(legs[j].Date)
+		})
+		ties := map[string]*BracketTie{}
+		for _, m := range legs {
+			key := tieKey(&m)
+			t, ok := ties[key]
+			if !ok {
+				t = &BracketTie{
+					StageId:         s.Id,
+					StageName:       round.Name,
+					Round:           len(b.Rounds),
+					HomeTeamId:      m.HomeTeam.Id,
+					AwayTeamId:      m.AwayTeam.Id,
+					HomePlaceholder: m.PlaceHolderA,
+					AwayPlaceholder: m.PlaceHolderB,
+					ThirdPlace:      strings.Contains(strings.ToLower(round.Name), "third"),
+				}
+				ties[key] = t
+				round.Ties = append(round.Ties, t)
+			}
+			t.Legs = append(t.Legs, m)
+		}
+		for _, t := range round.Ties {
+			t.resolve()
+		}
+		b.Rounds = append(b.Rounds, round)
+	}
+	b.link()
+	return b
+}
+
+func stagesFromMatches(matches []MatchResponse) []StageResponse {
+	seen := map[string]int{}
+	var stages []StageResponse
+	sorted := append([]MatchResponse(nil), matches...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+	for _, m := range sorted {
+		if _, ok := seen[m.StageId]; ok {
+			continue
+		}
+		seen[m.StageId] = len(stages)
+		stages = append(stages, StageResponse{
+			Id:            m.StageId,
+			CompetitionId: m.CompetitionId,
+			SeasonId:      m.SeasonId,
+			Name:          m.StageName,
+			SequenceOrder: len(stages),
+		})
+	}
+	return stages
+}
+
+// tieKey identifies the pairing of a match regardless of which leg it is.
+func tieKey(m *MatchResponse) string {
+	slot := func(team *TeamResponse, placeholder string) string {
+		if team.Id != "" {
+			return team.Id
+		}
+		if placeholder != "" {
+			return "?" + placeholder
+		}
+		return ""
+	}
+	a := slot(&m.HomeTeam, m.PlaceHolderA)
+	b := slot(&m.AwayTeam, m.PlaceHolderB)
+	if a == "" || b == "" {
+		return "#" + m.Id
+	}
+	if b < a {
+		a, b = b, a
+	}
+	return a + "|" + b
+}
+
+// resolve computes the aggregate score and winner of the tie from its legs.
+func (t *BracketTie) resolve() {
+	for i := range t.Legs {
+		m := &t.Legs[i]
+		if m.Status != PLAYED && m.Status != LIVE {
+			continue
+		}
+		home, away := m.HomeTeam.Score, m.AwayTeam.Score
+		if t.HomeTeamId != "" && m.HomeTeam.Id == t.AwayTeamId {
+			home, away = away, home
+		}
+		t.HomeScore += home
+		t.AwayScore += away
+		if hadExtraTime(m) {
+			t.ExtraTime = true
+		}
+	}
+	last := &t.Legs[len(t.Legs)-1]
+	swapped := t.HomeTeamId != "" && last.HomeTeam.Id == t.AwayTeamId
+	if t.TwoLegged() && (last.AggregateHomeTeamScore > 0 || last.AggregateAwayTeamScore > 0) {
+		t.HomeScore, t.AwayScore = last.AggregateHomeTeamScore, last.AggregateAwayTeamScore
+		if swapped {
+			t.HomeScore, t.AwayScore = t.AwayScore, t.HomeScore
+		}
+	}
+	t.HomePenaltyScore, t.AwayPenaltyScore = last.HomeTeamPenaltyScore, last.AwayTeamPenaltyScore
+	if swapped {
+		t.HomePenaltyScore, t.AwayPenaltyScore = t.AwayPenaltyScore, t.HomePenaltyScore
+	}
+	if last.Status != PLAYED {
+		return
+	}
+	t.WinnerId = last.WinnerId
+	if t.WinnerId == "" && t.HomeTeamId != "" && t.AwayTeamId != "" {
+		switch {
+		case t.HomeScore > t.AwayScore, t.HomeScore == t.AwayScore && t.HomePenaltyScore > t.AwayPenaltyScore:
+			t.WinnerId = t.HomeTeamId
+		case t.AwayScore > t.HomeScore, t.HomeScore == t.AwayScore && t.AwayPenaltyScore > t.HomePenaltyScore:
+			t.WinnerId = t.AwayTeamId
+		}
+	}
+}
+
+// link connects every tie to the ties feeding its slots, using "W<n>" and
+// "L<n>" placeholders that refer to match numbers, or else the teams that
+// played in an earlier round.
+func (b *Bracket) link() {
+	for r, round := range b.Rounds {
+		for _, t := range round.Ties {
+			t.HomeFeeder = b.feeder(r, t, t.HomeTeamId, t.HomePlaceholder)
+			t.AwayFeeder = b.feeder(r, t, t.AwayTeamId, t.AwayPlaceholder)
+			if t.ThirdPlace {
+				continue
+			}
+			for _, f := range []*BracketTie{t.HomeFeeder, t.AwayFeeder} {
+				if f != nil && f.Next == nil {
+					f.Next = t
+				}
+			}
+		}
+	}
+}
+
+func (b *Bracket) feeder(round int, t *BracketTie, teamId string, placeholder string) *BracketTie {
+	if n, ok := placeholderMatchNumber(placeholder); ok {
+		for r := round - 1; r >= 0; r-- {
+			for _, f := range b.Rounds[r].Ties {
+				if f.hasMatchNumber(n) {
+					return f
+				}
+			}
+		}
+	}
+	if teamId == "" {
+		return nil
+	}
+	for r := round - 1; r >= 0; r-- {
+		for _, f := range b.Rounds[r].Ties {
+			if f.ThirdPlace || !f.hasTeam(teamId) {
+				continue
+			}
+			if !f.Decided() {
+				return f
+			}
+			if t.ThirdPlace && f.LoserId() == teamId || !t.ThirdPlace && f.WinnerId == teamId {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+func placeholderMatchNumber(placeholder string) (int, bool) {
+	p := strings.ToUpper(strings.TrimSpace(placeholder))
+	if len(p) < 2 || p[0] != 'W' && p[0] != 'L' {
+		return 0, false
+	}
+	n, err := strconv.Atoi(p[1:])
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// Ties returns every tie, round by round.
+func (b *Bracket) Ties() []*BracketTie {
+	var out []*BracketTie
+	for _, r := range b.Rounds {
+		out = append(out, r.Ties...)
+	}
+	return out
+}
+
+// Final returns the tie of the last round that is not a third place play-off.
+func (b *Bracket) Final() *BracketTie {
+	for r := len(b.Rounds) - 1; r >= 0; r-- {
+		for _, t := range b.Rounds[r].Ties {
+			if !t.ThirdPlace {
+				return t
+			}
+		}
+	}
+	return nil
+}
+
+func (b *Bracket) ThirdPlace() *BracketTie {
+	for _, t := range b.Ties() {
+		if t.ThirdPlace {
+			return t
+		}
+	}
+	return nil
+}
+
+// TieForMatch returns the tie the given match is a leg of.
+func (b *Bracket) TieForMatch(matchId string) *BracketTie {
+	for _, t := range b.Ties() {
+		for _, m := range t.Legs {
+			if m.Id == matchId {
+				return t
+			}
+		}
+	}
+	return nil
+}
+
+// Path returns the ties a team played in, in round order.
+func (b *Bracket) Path(teamId string) []*BracketTie {
+	var out []*BracketTie
+	for _, t := range b.Ties() {
+		if t.hasTeam(teamId) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// Walk visits a tie and its feeders depth first, stopping when fn returns
+// false.
+func (t *BracketTie) Walk(fn func(*BracketTie) bool) bool {
+	if t == nil {
+		return true
+	}
+	if !fn(t) {
+		return false
+	}
+	return t.HomeFeeder.Walk(fn) && t.AwayFeeder.Walk(fn)
+}
+
+// GetBracket builds the knockout bracket of a season from its stages and
+// calendar.
+func (c *Client) GetBracket(opts *GetSeasonMatchesOptions) (*Bracket, error) {
+	stages, err := c.GetStages(&GetStagesOptions{
+		CompetitionId: opts.CompetitionId,
+		SeasonId:      opts.SeasonId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	matches, err := c.GetSeasonMatches(opts)
+	if err != nil {
+		return nil, err
+	}
+	return NewBracket(matches, stages), nil
+}
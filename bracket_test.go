@@ -0,0 +1,124 @@
+package go_fifa_test
+
+import (
+	"testing"
+	"time"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func bracketStage(id string, order int, name string) fifa.StageResponse {
+	return fifa.StageResponse{
+		Id:            id,
+		SequenceOrder: order,
+		Name:          []fifa.DefaultDescriptionResponse{{Locale: "en-GB", Description: name}},
+	}
+}
+
+func testBracket() *fifa.Bracket {
+	semi1 := testMatch("m61", "A", 1, "B", 1, numbered(61), inStage("sf"), playedOnDay(time.December, 1))
+	semi1.HomeTeamPenaltyScore = 4
+	semi1.AwayTeamPenaltyScore = 3
+	semi1.Period = fifa.SHOOTOUT
+	semi1.WinnerId = "A"
+	leg1 := testMatch("m62a", "C", 2, "D", 0, numbered(62), inStage("sf"), playedOnDay(time.December, 2))
+	leg2 := testMatch("m62b", "D", 1, "C", 0, numbered(62), inStage("sf"), playedOnDay(time.December, 5))
+	leg2.AggregateHomeTeamScore = 1
+	leg2.AggregateAwayTeamScore = 2
+	leg2.WinnerId = "C"
+	third := testMatch("m63", "B", 2, "D", 1, numbered(63), inStage("3rd"), playedOnDay(time.December, 9))
+	third.PlaceHolderA = "L61"
+	third.PlaceHolderB = "L62"
+	third.WinnerId = "B"
+	final := testMatch("m64", "", 0, "", 0, numbered(64), inStage("final"), playedOnDay(time.December, 10))
+	final.Status = fifa.TO_BE_PLAYED
+	final.PlaceHolderA = "W61"
+	final.PlaceHolderB = "W62"
+	group := testMatch("g1", "A", 0, "C", 0, numbered(1), inStage("group"), playedOnDay(time.December, 1))
+	stages := []fifa.StageResponse{
+		bracketStage("final", 4, "Final"),
+		bracketStage("group", 1, "First Stage"),
+		bracketStage("sf", 2, "Semi-final"),
+		bracketStage("3rd", 3, "Play-off for third place"),
+	}
+	return fifa.NewBracket([]fifa.MatchResponse{final, third, leg2, semi1, group, leg1}, stages)
+}
+
+func TestNewBracket(t *testing.T) {
+	t.Parallel()
+	b := testBracket()
+	if ok := assert.Len(t, b.Rounds, 3); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "Semi-final", b.Rounds[0].Name)
+	if ok := assert.Len(t, b.Rounds[0].Ties, 2); !ok {
+		t.FailNow()
+	}
+
+	semi1 := b.Rounds[0].Ties[0]
+	assert.Equal(t, "A", semi1.WinnerId)
+	assert.Equal(t, "B", semi1.LoserId())
+	assert.True(t, semi1.ExtraTime)
+	assert.True(t, semi1.Penalties())
+	assert.False(t, semi1.TwoLegged())
+
+	semi2 := b.TieForMatch("m62b")
+	if ok := assert.NotNil(t, semi2); !ok {
+		t.FailNow()
+	}
+	assert.True(t, semi2.TwoLegged())
+	assert.Equal(t, "C", semi2.HomeTeamId)
+	assert.Equal(t, 2, semi2.HomeScore)
+	assert.Equal(t, 1, semi2.AwayScore)
+	assert.Equal(t, "C", semi2.WinnerId)
+
+	final := b.Final()
+	if ok := assert.NotNil(t, final); !ok {
+		t.FailNow()
+	}
+	assert.False(t, final.Decided())
+	assert.Equal(t, "", final.HomeTeamId)
+	assert.Equal(t, "W61", final.HomePlaceholder)
+	assert.Same(t, semi1, final.HomeFeeder)
+	assert.Same(t, semi2, final.AwayFeeder)
+	assert.Same(t, final, semi1.Next)
+	assert.Same(t, final, semi2.Next)
+
+	third := b.ThirdPlace()
+	if ok := assert.NotNil(t, third); !ok {
+		t.FailNow()
+	}
+	assert.Same(t, semi1, third.HomeFeeder)
+	assert.Same(t, semi2, third.AwayFeeder)
+	assert.Nil(t, third.Next)
+
+	var visited []string
+	final.Walk(func(tie *fifa.BracketTie) bool {
+		visited = append(visited, tie.StageId)
+		return true
+	})
+	assert.Equal(t, []string{"final", "sf", "sf"}, visited)
+
+	path := b.Path("B")
+	if ok := assert.Len(t, path, 2); ok {
+		assert.Same(t, semi1, path[0])
+		assert.Same(t, third, path[1])
+	}
+}
+
+func TestNewBracketWithoutStages(t *testing.T) {
+	t.Parallel()
+	qf := testMatch("q", "A", 2, "B", 0, numbered(1), inStage("qf"), playedOnDay(time.December, 1))
+	qf.StageName = []fifa.DefaultDescriptionResponse{{Description: "Quarter-final"}}
+	sf := testMatch("s", "A", 0, "C", 1, numbered(2), inStage("sf"), playedOnDay(time.December, 5))
+	sf.StageName = []fifa.DefaultDescriptionResponse{{Description: "Semi-final"}}
+	b := fifa.NewBracket([]fifa.MatchResponse{sf, qf}, nil)
+	if ok := assert.Len(t, b.Rounds, 2); !ok {
+		t.FailNow()
+	}
+	final := b.Final()
+	assert.Equal(t, "C", final.WinnerId, "winner should follow from the score without WinnerId")
+	assert.Equal(t, "q", final.HomeFeeder.Legs[0].Id)
+	assert.Nil(t, final.AwayFeeder)
+}
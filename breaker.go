@@ -0,0 +1,218 @@
+package go_fifa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultFailureThreshold = 5
+	defaultCoolDown         = 30 * time.Second
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+type BreakerState int
+
+const (
+	BreakerClosed BreakerState = iota
+	BreakerOpen
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("BreakerState(%d)", int(s))
+}
+
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failures that opens the circuit.
+	FailureThreshold int
+	// CoolDown is how long the circuit stays open before a probe request is let through.
+	CoolDown time.Duration
+	// Thresholds overrides FailureThreshold for individual endpoint families.
+	Thresholds map[string]int
+}
+
+// CircuitBreaker tracks upstream failures per endpoint family, which is the
+// first segment of the request path (e.g. "live", "timelines", "calendar").
+type CircuitBreaker struct {
+	options  CircuitBreakerOptions
+	now      func() time.Time
+	mu       sync.Mutex
+	families map[string]*breakerFamily
+}
+
+type breakerFamily struct {
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewCircuitBreaker(options *CircuitBreakerOptions) *CircuitBreaker {
+	b := &CircuitBreaker{
+		now:      time.Now,
+		families: map[string]*breakerFamily{},
+	}
+	if options != nil {
+		b.options = *options
+	}
+	if b.options.FailureThreshold <= 0 {
+		b.options.FailureThreshold = defaultFailureThreshold
+	}
+	if b.options.CoolDown <= 0 {
+		b.options.CoolDown = defaultCoolDown
+	}
+	return b
+}
+
+// State returns the current state of the given endpoint family.
+func (b *CircuitBreaker) State(family string) BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	f, ok := b.families[family]
+	if !ok {
+		return BreakerClosed
+	}
+	return b.currentState(f)
+}
+
+// States returns the state of every endpoint family that has been used.
+func (b *CircuitBreaker) States() map[string]BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	states := make(map[string]BreakerState, len(b.families))
+	for name, f := range b.families {
+		states[name] = b.currentState(f)
+	}
+	return states
+}
+
+// Reset closes the circuit for the given endpoint family.
+func (b *CircuitBreaker) Reset(family string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.families, family)
+}
+
+func (b *CircuitBreaker) currentState(f *breakerFamily) BreakerState {
+	if f.state == BreakerOpen && b.now().Sub(f.openedAt) >= b.options.CoolDown {
+		return BreakerHalfOpen
+	}
+	return f.state
+}
+
+func (b *CircuitBreaker) threshold(family string) int {
+	if t, ok := b.options.Thresholds[family]; ok && t > 0 {
+		return t
+	}
+	return b.options.FailureThreshold
+}
+
+func (b *CircuitBreaker) allow(family string) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	f, ok := b.families[family]
+	if !ok {
+		return nil
+	}
+	switch b.currentState(f) {
+	case BreakerOpen:
+		return fmt.Errorf("%w: %s", ErrCircuitOpen, family)
+	case BreakerHalfOpen:
+		if f.probing {
+			return fmt.Errorf("%w: %s", ErrCircuitOpen, family)
+		}
+		f.state = BreakerHalfOpen
+		f.probing = true
+	}
+	return nil
+}
+
+// release lets another probe through after a request allowed by allow was
+// never sent.
+func (b *CircuitBreaker) release(family string) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if f, ok := b.families[family]; ok {
+		f.probing = false
+	}
+}
+
+func (b *CircuitBreaker) record(family string, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	f, ok := b.families[family]
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		// A cancelled request says nothing about the upstream; let another
+		// probe through.
+		if ok {
+			f.probing = false
+		}
+		return
+	}
+	if !isBreakerFailure(err) {
+		if ok {
+			delete(b.families, family)
+		}
+		return
+	}
+	if !ok {
+		f = &breakerFamily{}
+		b.families[family] = f
+	}
+	f.failures++
+	if f.state == BreakerHalfOpen || f.failures >= b.threshold(family) {
+		f.state = BreakerOpen
+		f.openedAt = b.now()
+		f.probing = false
+	}
+}
+
+func isBreakerFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError || apiErr.StatusCode == http.StatusTooManyRequests
+	}
+	return errors.Is(err, errTransport)
+}
+
+func endpointFamily(path string) string {
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.IndexByte(path, '/'); i >= 0 {
+		path = path[:i]
+	}
+	return path
+}
+
+func (c *Client) BreakerStates() map[string]BreakerState {
+	if c.Breaker == nil {
+		return nil
+	}
+	return c.Breaker.States()
+}
@@ -0,0 +1,110 @@
+package go_fifa_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreakerOpensAfterFailures(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{responses: map[string]stubResponse{
+		"/competitions": {Status: http.StatusBadGateway},
+	}}
+	client := fifa.Client{
+		Client:  stub,
+		Breaker: fifa.NewCircuitBreaker(&fifa.CircuitBreakerOptions{FailureThreshold: 2, CoolDown: time.Hour}),
+	}
+	for i := 0; i < 2; i++ {
+		_, err := client.GetCompetitions()
+		var apiErr *fifa.APIError
+		if ok := assert.True(t, errors.As(err, &apiErr), "expected APIError, got: %v", err); !ok {
+			t.FailNow()
+		}
+	}
+	assert.Equal(t, fifa.BreakerOpen, client.Breaker.State("competitions"))
+	_, err := client.GetCompetitions()
+	assert.True(t, errors.Is(err, fifa.ErrCircuitOpen), "expected ErrCircuitOpen, got: %v", err)
+	assert.Equal(t, 2, stub.requestCount(), "open circuit should not reach upstream")
+	assert.Equal(t, fifa.BreakerClosed, client.Breaker.State("teams"), "other families should be unaffected")
+}
+
+func TestCircuitBreakerHalfOpenProbe(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{responses: map[string]stubResponse{
+		"/competitions": {Status: http.StatusServiceUnavailable},
+	}}
+	client := fifa.Client{
+		Client:  stub,
+		Breaker: fifa.NewCircuitBreaker(&fifa.CircuitBreakerOptions{FailureThreshold: 1, CoolDown: 10 * time.Millisecond}),
+	}
+	_, err := client.GetCompetitions()
+	assert.NotNil(t, err)
+	assert.Equal(t, fifa.BreakerOpen, client.Breaker.State("competitions"))
+
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, fifa.BreakerHalfOpen, client.Breaker.State("competitions"))
+
+	stub.mu.Lock()
+	stub.responses["/competitions"] = stubResponse{Body: `{"Results":[]}`}
+	stub.mu.Unlock()
+	_, err = client.GetCompetitions()
+	assert.Nil(t, err, "expected probe to succeed, got: %v", err)
+	assert.Equal(t, fifa.BreakerClosed, client.Breaker.State("competitions"))
+	assert.Equal(t, map[string]fifa.BreakerState{}, client.BreakerStates())
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{}
+	client := fifa.Client{
+		Client:  stub,
+		Breaker: fifa.NewCircuitBreaker(&fifa.CircuitBreakerOptions{FailureThreshold: 1}),
+	}
+	_, err := client.GetTeam(&fifa.GetTeamOptions{TeamId: "missing"})
+	assert.NotNil(t, err)
+	assert.Equal(t, fifa.BreakerClosed, client.Breaker.State("teams"))
+}
+
+type failingLimiter struct{}
+
+func (failingLimiter) Wait(ctx context.Context) error {
+	return errors.New("rate: wait would exceed context deadline")
+}
+
+func TestCircuitBreakerCheckedBeforeLimiter(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{responses: map[string]stubResponse{
+		"/competitions": {Status: http.StatusBadGateway},
+	}}
+	limiter := &countingLimiter{}
+	client := fifa.Client{
+		Client:  stub,
+		Limiter: limiter,
+		Breaker: fifa.NewCircuitBreaker(&fifa.CircuitBreakerOptions{FailureThreshold: 1, CoolDown: 10 * time.Millisecond}),
+	}
+	_, err := client.GetCompetitions()
+	assert.NotNil(t, err)
+	_, err = client.GetCompetitions()
+	assert.True(t, errors.Is(err, fifa.ErrCircuitOpen), "expected ErrCircuitOpen, got: %v", err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&limiter.calls), "an open circuit should fail without waiting for the limiter")
+
+	time.Sleep(20 * time.Millisecond)
+	client.Limiter = failingLimiter{}
+	_, err = client.GetCompetitions()
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, fifa.ErrCircuitOpen), "the limiter error should be returned")
+
+	client.Limiter = nil
+	stub.mu.Lock()
+	stub.responses["/competitions"] = stubResponse{Body: `{"Results":[]}`}
+	stub.mu.Unlock()
+	_, err = client.GetCompetitions()
+	assert.Nil(t, err, "a probe that never reached the limiter should not block the next one, got: %v", err)
+}
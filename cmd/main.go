@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	fifa "github.com/ImDevinC/go-fifa"
 )
@@ -25,23 +24,17 @@ func showCompetitions(client *fifa.Client) error {
 }
 
 func getCompetitionsByName(client *fifa.Client, input string) error {
-	comps, err := client.GetCompetitions()
+	results, err := client.SearchCompetitions(&fifa.SearchOptions{Query: input})
 	if err != nil {
 		return err
 	}
-	input = strings.ToLower(input)
-	var results []fifa.CompetitionResponse
-	for _, c := range comps {
-		name := strings.ToLower(c.Name[0].Description)
-		if strings.Contains(name, input) {
-			results = append(results, c)
-		}
-	}
 	if len(results) > 1 {
 		fmt.Println("Multiple tournaments returned")
-		fmt.Printf("%+v\n", results)
-	} else if len(results) == 0 {
-		fmt.Println("Found " + results[0].Name[0].Description)
+		for _, r := range results {
+			fmt.Printf("%s: %s (%.2f)\n", r.Id, r.Name, r.Score)
+		}
+	} else if len(results) == 1 {
+		fmt.Println("Found " + results[0].Name)
 	} else {
 		fmt.Println("No results found")
 	}
@@ -54,18 +47,17 @@ func getLiveMatches(client *fifa.Client) error {
 		return err
 	}
 	for _, m := range matches {
-		fmt.Printf("%s: %s vs %s (%s/%s/%s/%s)\n", m.Competition[0].Description, m.HomeTeam.Name[0].Description, m.AwayTeam.Name[0].Description, m.CompetitionId, m.SeasonId, m.StageId, m.Id)
+		fmt.Printf("%s: %s vs %s (%s)\n", m.Competition[0].Description, m.HomeTeam.Name[0].Description, m.AwayTeam.Name[0].Description, m.Ref())
 	}
 	return nil
 }
 
 func getMatchData(client *fifa.Client) error {
-	events, err := client.GetMatchEvents(&fifa.GetMatchEventOptions{
-		CompetitionId: "2000000005",
-		SeasonId:      "400250052",
-		StageId:       "b1ayaoa4q68n6464fy4orklqs",
-		MatchId:       "3y748w6ppuxciynnoonrt9jx0",
-	})
+	ref, err := fifa.ParseMatchRef("2000000005/400250052/b1ayaoa4q68n6464fy4orklqs/3y748w6ppuxciynnoonrt9jx0")
+	if err != nil {
+		return err
+	}
+	events, err := client.GetMatchEvents(&ref)
 	if err != nil {
 		return err
 	}
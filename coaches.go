@@ -0,0 +1,23 @@
+package go_fifa
+
+import (
+	"errors"
+	"fmt"
+)
+
+type GetCoachOptions struct {
+	CoachId string
+}
+
+func (c *Client) GetCoach(opts *GetCoachOptions) (*CoachResponse, error) {
+	if opts.CoachId == "" {
+		return nil, errors.New("coachId is required but was not provided")
+	}
+	var coach CoachResponse
+	url := fmt.Sprintf("/coaches/%s", opts.CoachId)
+	_, err := c.get(url, &coach, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &coach, nil
+}
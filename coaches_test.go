@@ -0,0 +1,27 @@
+package go_fifa_test
+
+import (
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCoach(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: &stubHTTPClient{responses: map[string]stubResponse{
+		"/coaches/39468": {Body: `{"IdCoach":"39468","IdCountry":"FRA","Name":[{"Locale":"en-GB","Description":"Didier DESCHAMPS"}]}`},
+	}}}
+	resp, err := client.GetCoach(&fifa.GetCoachOptions{CoachId: "39468"})
+	if ok := assert.Nil(t, err, "expected no error with GetCoach, got: %s", err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "Didier DESCHAMPS", resp.Name[0].Description)
+}
+
+func TestGetCoachRequiresId(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{}
+	_, err := client.GetCoach(&fifa.GetCoachOptions{})
+	assert.NotNil(t, err)
+}
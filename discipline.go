@@ -0,0 +1,236 @@
+package go_fifa
+
+import (
+	"sort"
+	"strings"
+)
+
+type SuspensionReason string
+
+const (
+	SuspensionYellowCards  SuspensionReason = "yellow card accumulation"
+	SuspensionSecondYellow SuspensionReason = "second yellow card"
+	SuspensionRedCard      SuspensionReason = "red card"
+)
+
+type DisciplineRules struct {
+	// YellowsForBan is the number of yellow cards, received in separate
+	// matches, that trigger a ban.
+	YellowsForBan          int
+	YellowBanMatches       int
+	SecondYellowBanMatches int
+	RedCardBanMatches      int
+	// ResetAfterStages lists stage names after which single yellow cards are
+	// wiped, e.g. "Quarter-final". Pending bans are still served.
+	ResetAfterStages []string
+}
+
+var WorldCupDisciplineRules = DisciplineRules{
+	YellowsForBan:          2,
+	YellowBanMatches:       1,
+	SecondYellowBanMatches: 1,
+	RedCardBanMatches:      1,
+	ResetAfterStages:       []string{"Quarter-final"},
+}
+
+type PlayerDiscipline struct {
+	PlayerId string
+	TeamId   string
+	// Yellows is the number of yellow cards counting towards the next ban.
+	Yellows      int
+	TotalYellows int
+	TotalReds    int
+	// BannedMatches is the number of matches still to be served.
+	BannedMatches int
+	Reason        SuspensionReason
+}
+
+// DisciplineTracker accumulates cards over the matches of a season and keeps
+// track of the suspensions they trigger.
+type DisciplineTracker struct {
+	Rules     DisciplineRules
+	players   map[string]*PlayerDiscipline
+	seen      map[string]bool
+	lastStage []DefaultDescriptionResponse
+}
+
+func NewDisciplineTracker(rules DisciplineRules) *DisciplineTracker {
+	return &DisciplineTracker{
+		Rules:   rules,
+		players: map[string]*PlayerDiscipline{},
+		seen:    map[string]bool{},
+	}
+}
+
+// AddMatches adds finished matches in date order. Matches that have not been
+// played or were already added are ignored.
+func (d *DisciplineTracker) AddMatches(matches []MatchResponse) {
+	sorted := append([]MatchResponse(nil), matches...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+	for i := range sorted {
+		d.AddMatch(&sorted[i])
+	}
+}
+
+// AddMatch adds a single finished match. Matches must be added in the order
+// they were played.
+func (d *DisciplineTracker) AddMatch(m *MatchResponse) {
+	if m.Status != PLAYED || d.seen[m.Id] {
+		return
+	}
+	d.seen[m.Id] = true
+	if d.lastStage != nil && stageMatches(d.lastStage, d.Rules.ResetAfterStages) && !sameStage(d.lastStage, m.StageName) {
+		for _, p := range d.players {
+			p.Yellows = 0
+		}
+	}
+	d.lastStage = m.StageName
+	for _, team := range []*TeamResponse{&m.HomeTeam, &m.AwayTeam} {
+		for _, p := range d.players {
+			if p.TeamId == team.Id && p.BannedMatches > 0 {
+				p.BannedMatches--
+				if p.BannedMatches == 0 {
+					p.Reason = ""
+				}
+			}
+		}
+	}
+	for _, team := range []*TeamResponse{&m.HomeTeam, &m.AwayTeam} {
+		d.applyBookings(team)
+	}
+}
+
+func (d *DisciplineTracker) player(id string, teamId string) *PlayerDiscipline {
+	p, ok := d.players[id]
+	if !ok {
+		p = &PlayerDiscipline{PlayerId: id}
+		d.players[id] = p
+	}
+	if teamId != "" {
+		p.TeamId = teamId
+	}
+	return p
+}
+
+func (d *DisciplineTracker) ban(p *PlayerDiscipline, matches int, reason SuspensionReason) {
+	if matches <= 0 {
+		return
+	}
+	p.BannedMatches += matches
+	p.Reason = reason
+}
+
+func (d *DisciplineTracker) applyBookings(team *TeamResponse) {
+	sentOff := map[string]bool{}
+	for _, b := range team.Bookings {
+		if b.Card == YELLOW_RED {
+			sentOff[b.PlayerId] = true
+		}
+	}
+	for _, b := range team.Bookings {
+		if b.PlayerId == "" {
+			continue
+		}
+		teamId := b.TeamId
+		if teamId == "" {
+			teamId = team.Id
+		}
+		p := d.player(b.PlayerId, teamId)
+		switch b.Card {
+		case YELLOW:
+			p.TotalYellows++
+			// The first yellow of a match ending in a second yellow does
+			// not count towards accumulation.
+			if sentOff[b.PlayerId] {
+				continue
+			}
+			p.Yellows++
+			if d.Rules.YellowsForBan > 0 && p.Yellows >= d.Rules.YellowsForBan {
+				p.Yellows = 0
+				d.ban(p, d.Rules.YellowBanMatches, SuspensionYellowCards)
+			}
+		case YELLOW_RED:
+			p.TotalYellows++
+			p.TotalReds++
+			d.ban(p, d.Rules.SecondYellowBanMatches, SuspensionSecondYellow)
+		case RED:
+			p.TotalReds++
+			d.ban(p, d.Rules.RedCardBanMatches, SuspensionRedCard)
+		}
+	}
+}
+
+func stageMatches(names []DefaultDescriptionResponse, stages []string) bool {
+	for _, n := range names {
+		for _, s := range stages {
+			if strings.EqualFold(n.Description, s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sameStage(a []DefaultDescriptionResponse, b []DefaultDescriptionResponse) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	return a[0].Description == b[0].Description
+}
+
+func (d *DisciplineTracker) Player(playerId string) *PlayerDiscipline {
+	p, ok := d.players[playerId]
+	if !ok {
+		return nil
+	}
+	out := *p
+	return &out
+}
+
+// Suspensions returns the players who are banned from their team's next
+// match.
+func (d *DisciplineTracker) Suspensions() []PlayerDiscipline {
+	return d.filter(func(p *PlayerDiscipline) bool {
+		return p.BannedMatches > 0
+	})
+}
+
+// AtRisk returns the players who are one yellow card away from a ban.
+func (d *DisciplineTracker) AtRisk() []PlayerDiscipline {
+	if d.Rules.YellowsForBan <= 0 {
+		return nil
+	}
+	return d.filter(func(p *PlayerDiscipline) bool {
+		return p.Yellows == d.Rules.YellowsForBan-1
+	})
+}
+
+func (d *DisciplineTracker) filter(keep func(p *PlayerDiscipline) bool) []PlayerDiscipline {
+	var out []PlayerDiscipline
+	for _, p := range d.players {
+		if keep(p) {
+			out = append(out, *p)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].TeamId != out[j].TeamId {
+			return out[i].TeamId < out[j].TeamId
+		}
+		return out[i].PlayerId < out[j].PlayerId
+	})
+	return out
+}
+
+// GetSeasonDiscipline builds a discipline tracker from every finished match
+// of a season.
+func (c *Client) GetSeasonDiscipline(opts *GetSeasonMatchesOptions, rules DisciplineRules) (*DisciplineTracker, error) {
+	matches, err := c.GetSeasonMatches(opts)
+	if err != nil {
+		return nil, err
+	}
+	d := NewDisciplineTracker(rules)
+	d.AddMatches(matches)
+	return d, nil
+}
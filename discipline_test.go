@@ -0,0 +1,62 @@
+package go_fifa_test
+
+import (
+	"testing"
+	"time"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func booking(card fifa.CardType, team string, player string) fifa.BookingResponse {
+	return fifa.BookingResponse{Card: card, TeamId: team, PlayerId: player}
+}
+
+func TestDisciplineTracker(t *testing.T) {
+	t.Parallel()
+	matches := []fifa.MatchResponse{
+		testMatch("2", "A", 0, "C", 0, playedOnDay(time.November, 25), stageNamed("First Stage"), withBookings(booking(fifa.YELLOW, "A", "a1"), booking(fifa.RED, "C", "c1"))),
+		testMatch("1", "A", 0, "B", 0, playedOnDay(time.November, 21), stageNamed("First Stage"), withBookings(booking(fifa.YELLOW, "A", "a1"), booking(fifa.YELLOW, "B", "b1"), booking(fifa.YELLOW_RED, "B", "b1"), booking(fifa.YELLOW, "B", "b2"))),
+	}
+	d := fifa.NewDisciplineTracker(fifa.WorldCupDisciplineRules)
+	d.AddMatches(matches)
+
+	suspended := d.Suspensions()
+	if ok := assert.Len(t, suspended, 3); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "a1", suspended[0].PlayerId)
+	assert.Equal(t, fifa.SuspensionYellowCards, suspended[0].Reason)
+	assert.Equal(t, "b1", suspended[1].PlayerId)
+	assert.Equal(t, fifa.SuspensionSecondYellow, suspended[1].Reason)
+	assert.Equal(t, 0, suspended[1].Yellows, "first yellow of a sending off should not count")
+	assert.Equal(t, "c1", suspended[2].PlayerId)
+
+	atRisk := d.AtRisk()
+	if ok := assert.Len(t, atRisk, 1); ok {
+		assert.Equal(t, "b2", atRisk[0].PlayerId)
+	}
+
+	// b1's ban is served when B plays again; unplayed matches are ignored.
+	next := testMatch("3", "B", 0, "C", 0, playedOnDay(time.November, 29), stageNamed("First Stage"))
+	upcoming := testMatch("4", "A", 0, "D", 0, playedOnDay(time.November, 30), stageNamed("First Stage"))
+	upcoming.Status = fifa.TO_BE_PLAYED
+	d.AddMatches([]fifa.MatchResponse{next, upcoming})
+	assert.Nil(t, d.Player("nobody"))
+	assert.Equal(t, 0, d.Player("b1").BannedMatches)
+	assert.Equal(t, 0, d.Player("c1").BannedMatches)
+	assert.Equal(t, 1, d.Player("a1").BannedMatches)
+	assert.Equal(t, 2, d.Player("a1").TotalYellows)
+}
+
+func TestDisciplineResetAfterStage(t *testing.T) {
+	t.Parallel()
+	d := fifa.NewDisciplineTracker(fifa.WorldCupDisciplineRules)
+	d.AddMatches([]fifa.MatchResponse{
+		testMatch("1", "A", 0, "B", 0, playedOnDay(time.November, 1), stageNamed("Quarter-final"), withBookings(booking(fifa.YELLOW, "A", "a1"))),
+		testMatch("2", "C", 0, "D", 0, playedOnDay(time.November, 2), stageNamed("Quarter-final")),
+		testMatch("3", "A", 0, "C", 0, playedOnDay(time.November, 5), stageNamed("Semi-final"), withBookings(booking(fifa.YELLOW, "A", "a1"))),
+	})
+	assert.Empty(t, d.Suspensions(), "yellows should be wiped after the quarter-finals")
+	assert.Equal(t, 1, d.Player("a1").Yellows)
+}
@@ -0,0 +1,349 @@
+package go_fifa
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	defaultEloRating        = 1500
+	defaultEloHomeAdvantage = 100
+)
+
+type MatchImportance int
+
+const (
+	ImportanceFriendly MatchImportance = iota
+	ImportanceTournament
+	ImportanceQualifier
+	ImportanceContinentalFinals
+	ImportanceWorldCupFinals
+)
+
+// DefaultEloK holds the K factors of the World Football Elo Ratings.
+var DefaultEloK = map[MatchImportance]float64{
+	ImportanceFriendly:          20,
+	ImportanceTournament:        30,
+	ImportanceQualifier:         40,
+	ImportanceContinentalFinals: 50,
+	ImportanceWorldCupFinals:    60,
+}
+
+// nonSeniorWorldCupNames mark World Cups other than the senior men's and
+// women's finals, which are weighted as other tournaments.
+var nonSeniorWorldCupNames = []string{
+	"club", "youth", "under", "beach", "futsal", "esport", "ework", "interactive",
+}
+
+var ageGroupPattern = regexp.MustCompile(`\bu-?\d{2}\b`)
+
+var continentalFinalsNames = []string{
+	"euro", "copa am", "asian cup", "africa cup", "african cup", "gold cup", "nations cup", "confederations cup",
+}
+
+// ClassifyMatch derives the importance of a match from its competition and
+// stage names. Only the senior men's and women's World Cup finals get the
+// World Cup weight; club, youth, beach soccer and futsal World Cups count as
+// other tournaments.
+func ClassifyMatch(m *MatchResponse) MatchImportance {
+	var names []string
+	for _, n := range m.Competition {
+		names = append(names, strings.ToLower(n.Description))
+	}
+	for _, n := range m.StageName {
+		names = append(names, strings.ToLower(n.Description))
+	}
+	name := strings.Join(names, " ")
+	switch {
+	case strings.Contains(name, "friendl"):
+		return ImportanceFriendly
+	case strings.Contains(name, "qualif") || strings.Contains(name, "preliminar"):
+		return ImportanceQualifier
+	case strings.Contains(name, "world cup") && isSeniorWorldCup(name):
+		return ImportanceWorldCupFinals
+	}
+	for _, c := range continentalFinalsNames {
+		if strings.Contains(name, c) {
+			return ImportanceContinentalFinals
+		}
+	}
+	return ImportanceTournament
+}
+
+func isSeniorWorldCup(name string) bool {
+	if ageGroupPattern.MatchString(name) {
+		return false
+	}
+	for _, n := range nonSeniorWorldCupNames {
+		if strings.Contains(name, n) {
+			return false
+		}
+	}
+	return true
+}
+
+type EloOptions struct {
+	// K maps match importance to the K factor, defaulting to DefaultEloK.
+	K map[MatchImportance]float64
+	// HomeAdvantage is added to the rating of a team playing in its own
+	// country. Defaults to 100 when nil; point it at 0 for neutral ratings.
+	HomeAdvantage *float64
+	// InitialRating is the rating of teams without matches. Defaults to 1500
+	// when nil.
+	InitialRating *float64
+	// Importance overrides ClassifyMatch.
+	Importance func(m *MatchResponse) MatchImportance
+}
+
+type EloRating struct {
+	TeamId  string
+	Rating  float64
+	Matches int
+}
+
+type EloHistoryEntry struct {
+	MatchId    string
+	Date       time.Time
+	OpponentId string
+	Before     float64
+	After      float64
+}
+
+func (h EloHistoryEntry) Change() float64 {
+	return h.After - h.Before
+}
+
+// EloEngine rates teams with the World Football Elo formula. Matches must be
+// added in the order they were played; shoot-out results count as draws.
+type EloEngine struct {
+	options       EloOptions
+	homeAdvantage float64
+	initialRating float64
+	ratings       map[string]*EloRating
+	history       map[string][]EloHistoryEntry
+	processed     map[string]bool
+}
+
+func NewEloEngine(opts *EloOptions) *EloEngine {
+	e := &EloEngine{
+		ratings:   map[string]*EloRating{},
+		history:   map[string][]EloHistoryEntry{},
+		processed: map[string]bool{},
+	}
+	if opts != nil {
+		e.options = *opts
+	}
+	if e.options.K == nil {
+		e.options.K = DefaultEloK
+	}
+	e.homeAdvantage = defaultEloHomeAdvantage
+	if e.options.HomeAdvantage != nil {
+		e.homeAdvantage = *e.options.HomeAdvantage
+	}
+	e.initialRating = defaultEloRating
+	if e.options.InitialRating != nil {
+		e.initialRating = *e.options.InitialRating
+	}
+	if e.options.Importance == nil {
+		e.options.Importance = ClassifyMatch
+	}
+	return e
+}
+
+// AddMatches adds finished matches in date order. Matches that have not been
+// played or were already added are ignored.
+func (e *EloEngine) AddMatches(matches []MatchResponse) {
+	sorted := append([]MatchResponse(nil), matches...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date)
+	})
+	for i := range sorted {
+		e.AddMatch(&sorted[i])
+	}
+}
+
+// AddMatch rates a single finished match. It returns false if the match was
+// not played or was already added.
+func (e *EloEngine) AddMatch(m *MatchResponse) bool {
+	if m.Status != PLAYED || m.HomeTeam.Id == "" || m.AwayTeam.Id == "" || e.processed[m.Id] {
+		return false
+	}
+	if m.Id != "" {
+		e.processed[m.Id] = true
+	}
+	home, away := e.rating(m.HomeTeam.Id), e.rating(m.AwayTeam.Id)
+	expected := e.expected(home.Rating, away.Rating, playsAtHome(m, &m.HomeTeam), playsAtHome(m, &m.AwayTeam))
+
+	result := 0.5
+	switch {
+	case m.HomeTeam.Score > m.AwayTeam.Score:
+		result = 1
+	case m.HomeTeam.Score < m.AwayTeam.Score:
+		result = 0
+	}
+	k := e.options.K[e.options.Importance(m)]
+	change := k * goalDifferenceMultiplier(m.HomeTeam.Score-m.AwayTeam.Score) * (result - expected)
+
+	e.apply(home, m, m.AwayTeam.Id, change)
+	e.apply(away, m, m.HomeTeam.Id, -change)
+	return true
+}
+
+func (e *EloEngine) apply(r *EloRating, m *MatchResponse, opponentId string, change float64) {
+	before := r.Rating
+	r.Rating += change
+	r.Matches++
+	e.history[r.TeamId] = append(e.history[r.TeamId], EloHistoryEntry{
+		MatchId:    m.Id,
+		Date:       m.Date,
+		OpponentId: opponentId,
+		Before:     before,
+		After:      r.Rating,
+	})
+}
+
+// goalDifferenceMultiplier weights wins by their margin: 1.5 for two goals,
+// 1.75 for three and 1.75 + (N-3)/8 beyond.
+func goalDifferenceMultiplier(diff int) float64 {
+	if diff < 0 {
+		diff = -diff
+	}
+	switch {
+	case diff <= 1:
+		return 1
+	case diff == 2:
+		return 1.5
+	case diff == 3:
+		return 1.75
+	}
+	return 1.75 + float64(diff-3)/8
+}
+
+func (e *EloEngine) rating(teamId string) *EloRating {
+	r, ok := e.ratings[teamId]
+	if !ok {
+		r = &EloRating{TeamId: teamId, Rating: e.initialRating}
+		e.ratings[teamId] = r
+	}
+	return r
+}
+
+func (e *EloEngine) expected(home float64, away float64, homeAdv bool, awayAdv bool) float64 {
+	diff := home - away
+	if homeAdv {
+		diff += e.homeAdvantage
+	}
+	if awayAdv {
+		diff -= e.homeAdvantage
+	}
+	return 1 / (math.Pow(10, -diff/400) + 1)
+}
+
+// Expected returns the expected result of the home team, between 0 and 1,
+// with draws counting half. homeAdv tells whether it plays in its own country.
+func (e *EloEngine) Expected(homeTeamId string, awayTeamId string, homeAdv bool) float64 {
+	return e.expected(e.Rating(homeTeamId), e.Rating(awayTeamId), homeAdv, false)
+}
+
+func (e *EloEngine) Rating(teamId string) float64 {
+	if r, ok := e.ratings[teamId]; ok {
+		return r.Rating
+	}
+	return e.initialRating
+}
+
+// Ratings returns every rated team, best first.
+func (e *EloEngine) Ratings() []EloRating {
+	out := make([]EloRating, 0, len(e.ratings))
+	for _, r := range e.ratings {
+		out = append(out, *r)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Rating != out[j].Rating {
+			return out[i].Rating > out[j].Rating
+		}
+		return out[i].TeamId < out[j].TeamId
+	})
+	return out
+}
+
+// History returns the rating changes of a team in the order they were made.
+func (e *EloEngine) History(teamId string) []EloHistoryEntry {
+	return append([]EloHistoryEntry(nil), e.history[teamId]...)
+}
+
+// RatingAt returns a team's rating after the last match it played before t.
+func (e *EloEngine) RatingAt(teamId string, t time.Time) float64 {
+	rating := e.initialRating
+	for _, h := range e.history[teamId] {
+		if !h.Date.Before(t) {
+			break
+		}
+		rating = h.After
+	}
+	return rating
+}
+
+type eloState struct {
+	Ratings   []EloRating
+	History   map[string][]EloHistoryEntry
+	Processed []string
+}
+
+// Save writes the ratings, their history and the matches processed as JSON.
+func (e *EloEngine) Save(w io.Writer) error {
+	state := eloState{
+		Ratings: e.Ratings(),
+		History: e.history,
+	}
+	for id := range e.processed {
+		state.Processed = append(state.Processed, id)
+	}
+	sort.Strings(state.Processed)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(state)
+}
+
+// Load replaces the state of the engine with one written by Save, so matches
+// played since can be added.
+func (e *EloEngine) Load(r io.Reader) error {
+	var state eloState
+	if err := json.NewDecoder(r).Decode(&state); err != nil {
+		return err
+	}
+	e.ratings = map[string]*EloRating{}
+	for i := range state.Ratings {
+		rating := state.Ratings[i]
+		e.ratings[rating.TeamId] = &rating
+	}
+	e.history = state.History
+	if e.history == nil {
+		e.history = map[string][]EloHistoryEntry{}
+	}
+	e.processed = map[string]bool{}
+	for _, id := range state.Processed {
+		e.processed[id] = true
+	}
+	return nil
+}
+
+// GetEloRatings rates teams over the calendars of the given seasons.
+func (c *Client) GetEloRatings(seasons []GetSeasonMatchesOptions, opts *EloOptions) (*EloEngine, error) {
+	var matches []MatchResponse
+	for i := range seasons {
+		m, err := c.GetSeasonMatches(&seasons[i])
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, m...)
+	}
+	e := NewEloEngine(opts)
+	e.AddMatches(matches)
+	return e, nil
+}
@@ -0,0 +1,107 @@
+package go_fifa_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyMatch(t *testing.T) {
+	t.Parallel()
+	for name, want := range map[string]fifa.MatchImportance{
+		"FIFA World Cup Qatar 2022™":            fifa.ImportanceWorldCupFinals,
+		"FIFA World Cup Qualifier":              fifa.ImportanceQualifier,
+		"UEFA EURO 2024":                        fifa.ImportanceContinentalFinals,
+		"International Friendly":                fifa.ImportanceFriendly,
+		"Kirin Challenge Cup":                   fifa.ImportanceTournament,
+		"CONCACAF Gold Cup Preliminary Round":   fifa.ImportanceQualifier,
+		"CONMEBOL Copa América Argentina 2021™": fifa.ImportanceContinentalFinals,
+		"FIFA Women's World Cup 2023™":          fifa.ImportanceWorldCupFinals,
+		"FIFA Club World Cup":                   fifa.ImportanceTournament,
+		"FIFA U-20 World Cup":                   fifa.ImportanceTournament,
+		"FIFA U17 Women's World Cup":            fifa.ImportanceTournament,
+		"FIFA Beach Soccer World Cup":           fifa.ImportanceTournament,
+		"FIFA Futsal World Cup":                 fifa.ImportanceTournament,
+	} {
+		m := testMatch("", "A", 0, "B", 0, playedOnDay(time.November, 1), inCompetition(name))
+		assert.Equal(t, want, fifa.ClassifyMatch(&m), name)
+	}
+}
+
+func TestEloEngine(t *testing.T) {
+	t.Parallel()
+	e := fifa.NewEloEngine(nil)
+	final := testMatch("1", "A", 3, "B", 0, playedOnDay(time.November, 2), inCompetition("FIFA World Cup"))
+	friendly := testMatch("2", "C", 1, "D", 0, playedOnDay(time.November, 1), inCompetition("Friendly"))
+	friendly.Stadium.CountryId = "CCC"
+	friendly.HomeTeam.CountryId = "CCC"
+	shootout := testMatch("3", "E", 1, "F", 1, playedOnDay(time.November, 3), inCompetition("FIFA World Cup"))
+	shootout.HomeTeamPenaltyScore = 4
+	shootout.AwayTeamPenaltyScore = 2
+	e.AddMatches([]fifa.MatchResponse{final, friendly, shootout})
+
+	assert.InDelta(t, 1500+60*1.75*0.5, e.Rating("A"), 1e-9)
+	assert.InDelta(t, 1500-60*1.75*0.5, e.Rating("B"), 1e-9)
+	homeExpected := 1 / (1 + 1/1.7782794100389228)
+	assert.InDelta(t, 1500+20*(1-homeExpected), e.Rating("C"), 1e-9)
+	assert.Equal(t, 1500.0, e.Rating("E"), "a shoot-out counts as a draw")
+	assert.Equal(t, 1500.0, e.Rating("unknown"))
+
+	assert.False(t, e.AddMatch(&final), "matches are only rated once")
+	ratings := e.Ratings()
+	assert.Equal(t, "A", ratings[0].TeamId)
+	assert.Equal(t, 1, ratings[0].Matches)
+
+	history := e.History("A")
+	if ok := assert.Len(t, history, 1); ok {
+		assert.Equal(t, "B", history[0].OpponentId)
+		assert.InDelta(t, 52.5, history[0].Change(), 1e-9)
+	}
+	assert.Equal(t, 1500.0, e.RatingAt("A", final.Date))
+	assert.Equal(t, e.Rating("A"), e.RatingAt("A", final.Date.Add(time.Hour)))
+	assert.Greater(t, e.Expected("A", "B", false), 0.5)
+}
+
+func TestEloEngineNeutral(t *testing.T) {
+	t.Parallel()
+	none, zero := 0.0, 0.0
+	e := fifa.NewEloEngine(&fifa.EloOptions{HomeAdvantage: &none, InitialRating: &zero})
+	assert.Equal(t, 0.0, e.Rating("A"))
+	assert.Equal(t, 0.5, e.Expected("A", "B", true), "no home advantage should be applied")
+
+	m := testMatch("1", "A", 1, "B", 0, playedOnDay(time.November, 1), inCompetition("Friendly"))
+	m.Stadium.CountryId = "AAA"
+	m.HomeTeam.CountryId = "AAA"
+	e.AddMatch(&m)
+	assert.InDelta(t, 10, e.Rating("A"), 1e-9)
+
+	defaults := fifa.NewEloEngine(&fifa.EloOptions{})
+	assert.Equal(t, 1500.0, defaults.Rating("A"))
+	assert.Greater(t, defaults.Expected("A", "B", true), 0.5)
+}
+
+func TestEloEngineSaveLoad(t *testing.T) {
+	t.Parallel()
+	e := fifa.NewEloEngine(nil)
+	first := testMatch("1", "A", 2, "B", 1, playedOnDay(time.November, 1), inCompetition("Friendly"))
+	e.AddMatch(&first)
+
+	var buf bytes.Buffer
+	if ok := assert.Nil(t, e.Save(&buf)); !ok {
+		t.FailNow()
+	}
+	loaded := fifa.NewEloEngine(nil)
+	if ok := assert.Nil(t, loaded.Load(&buf)); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, e.Ratings(), loaded.Ratings())
+	assert.Equal(t, e.History("A"), loaded.History("A"))
+	assert.False(t, loaded.AddMatch(&first), "processed matches should survive a reload")
+
+	second := testMatch("2", "B", 1, "A", 0, playedOnDay(time.November, 2), inCompetition("Friendly"))
+	assert.True(t, loaded.AddMatch(&second))
+	assert.Len(t, loaded.History("A"), 2)
+}
@@ -1,20 +1,13 @@
 package go_fifa
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
-type GetMatchEventOptions struct {
-	CompetitionId string
-	SeasonId      string
-	StageId       string
-	MatchId       string
-}
-
 type GetMatchEventsResponse struct {
 	StageId       string          `json:"IdStage"`
 	MatchId       string          `json:"IdMatch"`
@@ -37,7 +30,7 @@ type EventResponse struct {
 	SubTeamId           string                       `json:"IdSubTeam"`
 	Timestamp           time.Time                    `json:"Timestamp"`
 	DateTimeUTC         time.Time                    `json:"DateTimeUTC"`
-	MatchMinute         string                       `json:"MatchMinute"`
+	MatchMinute         MatchMinute                  `json:"MatchMinute"`
 	Period              PeriodEnum                   `json:"Period"`
 	HomeGoals           int                          `json:"HomeGoals"`
 	AwayGoals           int                          `json:"AwayGoals"`
@@ -56,21 +49,16 @@ type EventResponse struct {
 }
 
 func (c *Client) GetMatchEvents(options *GetMatchEventOptions) (*GetMatchEventsResponse, error) {
-	if options.CompetitionId == "" {
-		return nil, errors.New("competitionId is required but not provIded")
-	}
-	if options.SeasonId == "" {
-		return nil, errors.New("seasonId is required but not provIded")
-	}
-	if options.StageId == "" {
-		return nil, errors.New("stageId is required but not provIded")
-	}
-	if options.MatchId == "" {
-		return nil, errors.New("matchId is required but not provIded")
+	return c.getMatchEvents(context.Background(), options)
+}
+
+func (c *Client) getMatchEvents(ctx context.Context, options *GetMatchEventOptions) (*GetMatchEventsResponse, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
 	}
 	url := fmt.Sprintf("/timelines/%s/%s/%s/%s", options.CompetitionId, options.SeasonId, options.StageId, options.MatchId)
 	var respData GetMatchEventsResponse
-	_, err := c.get(url, &respData, nil)
+	_, err := c.getWithContext(ctx, url, &respData, nil)
 	if err != nil {
 		return nil, err
 	}
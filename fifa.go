@@ -1,7 +1,9 @@
 package go_fifa
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -19,27 +21,81 @@ const (
 	defaultLanguage   = "en-US,en"
 )
 
+var errTransport = errors.New("failed to execute API request")
+
 type Client struct {
 	Client     HTTPClient
 	ApiBaseURL string
 	UserAgent  string
 	Language   string
+	Breaker    *CircuitBreaker
+	Limiter    RateLimiter
+}
+
+// RateLimiter is consulted before every request. *rate.Limiter from
+// golang.org/x/time/rate satisfies it.
+type RateLimiter interface {
+	Wait(ctx context.Context) error
 }
 
 type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// APIError is returned when the API responds with an error status code.
+type APIError struct {
+	StatusCode int
+	Status     string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("invalId response code: %s", e.Status)
+}
+
 func (c *Client) get(path string, respData interface{}, reqData interface{}) (interface{}, error) {
-	return c.sendRequest(http.MethodGet, path, respData, reqData)
+	return c.sendRequest(context.Background(), http.MethodGet, path, respData, reqData)
+}
+
+func (c *Client) getWithContext(ctx context.Context, path string, respData interface{}, reqData interface{}) (interface{}, error) {
+	return c.sendRequest(ctx, http.MethodGet, path, respData, reqData)
 }
 
-func (c *Client) sendRequest(method string, path string, respData interface{}, reqData interface{}) (interface{}, error) {
-	req, err := c.newRequest(method, path, reqData)
+// getAllPages calls fetch once per page of a paged endpoint, setting token
+// to the continuation token of the previous page, until the API stops
+// returning new tokens or results. fetch returns the continuation token and
+// the number of results of the page it read.
+func getAllPages(token *string, fetch func() (next string, results int, err error)) error {
+	for {
+		next, results, err := fetch()
+		if err != nil {
+			return err
+		}
+		if next == "" || next == *token || results == 0 {
+			return nil
+		}
+		*token = next
+	}
+}
+
+func (c *Client) sendRequest(ctx context.Context, method string, path string, respData interface{}, reqData interface{}) (interface{}, error) {
+	req, err := c.newRequest(ctx, method, path, reqData)
 	if err != nil {
 		return nil, err
 	}
+	// The breaker is checked first so an open circuit fails fast instead of
+	// waiting for, and using up, a rate limit token.
+	family := endpointFamily(path)
+	if err := c.Breaker.allow(family); err != nil {
+		return nil, err
+	}
+	if c.Limiter != nil {
+		if err := c.Limiter.Wait(ctx); err != nil {
+			c.Breaker.release(family)
+			return nil, err
+		}
+	}
 	err = c.doRequest(req, respData)
+	c.Breaker.record(family, err)
 	if err != nil {
 		return nil, err
 	}
@@ -47,16 +103,19 @@ func (c *Client) sendRequest(method string, path string, respData interface{}, r
 	return respData, nil
 }
 
-func (c *Client) newRequest(method string, path string, data interface{}) (*http.Request, error) {
-	if c.ApiBaseURL == "" {
-		c.ApiBaseURL = defaultAPIBaseURL
+func (c *Client) newRequest(ctx context.Context, method string, path string, data interface{}) (*http.Request, error) {
+	// Defaults are resolved per request rather than stored on c, since
+	// batches send requests from several goroutines at once.
+	baseURL := c.ApiBaseURL
+	if baseURL == "" {
+		baseURL = defaultAPIBaseURL
 	}
-	url := c.ApiBaseURL + path
-	return c.newStandardRequest(url, method, data)
+	url := baseURL + path
+	return c.newStandardRequest(ctx, url, method, data)
 }
 
-func (c *Client) newStandardRequest(url string, method string, data interface{}) (*http.Request, error) {
-	req, err := http.NewRequest(method, url, nil)
+func (c *Client) newStandardRequest(ctx context.Context, url string, method string, data interface{}) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -73,12 +132,16 @@ func (c *Client) newStandardRequest(url string, method string, data interface{})
 
 func (c *Client) doRequest(req *http.Request, resp interface{}) error {
 	c.setRequestHeaders(req)
-	if c.Client == nil {
-		c.Client = http.DefaultClient
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
 	}
-	response, err := c.Client.Do(req)
+	response, err := client.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to execute API request: %s", err.Error())
+		if ctxErr := req.Context().Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return fmt.Errorf("%w: %s", errTransport, err.Error())
 	}
 	defer response.Body.Close()
 	bodyBytes, err := ioutil.ReadAll(response.Body)
@@ -86,7 +149,7 @@ func (c *Client) doRequest(req *http.Request, resp interface{}) error {
 		return err
 	}
 	if response.StatusCode >= http.StatusBadRequest {
-		return fmt.Errorf("invalId response code: %s", response.Status)
+		return &APIError{StatusCode: response.StatusCode, Status: response.Status}
 	}
 	err = json.Unmarshal(bodyBytes, &resp)
 	if err != nil {
@@ -96,16 +159,14 @@ func (c *Client) doRequest(req *http.Request, resp interface{}) error {
 }
 
 func (c *Client) setRequestHeaders(req *http.Request) {
-	if c.UserAgent == "" {
-		c.UserAgent = defaultUserAgent
-	}
-	if c.UserAgent != "" {
-		req.Header.Add("User-Agent", c.UserAgent)
-	}
-	if c.Language == "" {
-		c.Language = defaultLanguage
+	userAgent := c.UserAgent
+	if userAgent == "" {
+		userAgent = defaultUserAgent
 	}
-	if c.Language != "" {
-		req.Header.Add("Accept-Language", c.Language)
+	req.Header.Add("User-Agent", userAgent)
+	language := c.Language
+	if language == "" {
+		language = defaultLanguage
 	}
+	req.Header.Add("Accept-Language", language)
 }
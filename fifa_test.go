@@ -0,0 +1,133 @@
+package go_fifa_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	fifa "github.com/ImDevinC/go-fifa"
+)
+
+type stubResponse struct {
+	Status int
+	Body   string
+}
+
+// stubHTTPClient serves canned responses keyed by request path, or by path
+// and query string when a more specific entry exists.
+type stubHTTPClient struct {
+	mu        sync.Mutex
+	responses map[string]stubResponse
+	err       error
+	requests  []*http.Request
+}
+
+func (s *stubHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, req)
+	if s.err != nil {
+		return nil, s.err
+	}
+	path := strings.TrimPrefix(req.URL.Path, "/api/v3")
+	resp, ok := s.responses[path+"?"+req.URL.RawQuery]
+	if !ok {
+		resp, ok = s.responses[path]
+	}
+	if !ok {
+		resp = stubResponse{Status: http.StatusNotFound, Body: "{}"}
+	}
+	if resp.Status == 0 {
+		resp.Status = http.StatusOK
+	}
+	return &http.Response{
+		StatusCode: resp.Status,
+		Status:     http.StatusText(resp.Status),
+		Body:       ioutil.NopCloser(strings.NewReader(resp.Body)),
+		Request:    req,
+	}, nil
+}
+
+func (s *stubHTTPClient) requestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.requests)
+}
+
+// matchOption sets a field of a match built by testMatch.
+type matchOption func(m *fifa.MatchResponse)
+
+// testMatch returns a played match with the given teams and score, adjusted
+// by opts.
+func testMatch(id string, home string, homeScore int, away string, awayScore int, opts ...matchOption) fifa.MatchResponse {
+	m := fifa.MatchResponse{
+		Id:       id,
+		Status:   fifa.PLAYED,
+		HomeTeam: fifa.TeamResponse{Id: home, Score: homeScore},
+		AwayTeam: fifa.TeamResponse{Id: away, Score: awayScore},
+	}
+	for _, opt := range opts {
+		opt(&m)
+	}
+	return m
+}
+
+func toBePlayed(m *fifa.MatchResponse) {
+	m.Status = fifa.TO_BE_PLAYED
+}
+
+func playedOn(date time.Time) matchOption {
+	return func(m *fifa.MatchResponse) {
+		m.Date = date
+	}
+}
+
+// playedOnDay dates the match in 2022.
+func playedOnDay(month time.Month, day int) matchOption {
+	return playedOn(time.Date(2022, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+func inGroupId(group string) matchOption {
+	return func(m *fifa.MatchResponse) {
+		m.GroupId = group
+	}
+}
+
+func inStage(stage string) matchOption {
+	return func(m *fifa.MatchResponse) {
+		m.StageId = stage
+	}
+}
+
+func numbered(number int) matchOption {
+	return func(m *fifa.MatchResponse) {
+		m.MatchNumber = number
+	}
+}
+
+func inCompetition(name string) matchOption {
+	return func(m *fifa.MatchResponse) {
+		m.Competition = []fifa.DefaultDescriptionResponse{{Locale: "en-GB", Description: name}}
+	}
+}
+
+func stageNamed(name string) matchOption {
+	return func(m *fifa.MatchResponse) {
+		m.StageName = []fifa.DefaultDescriptionResponse{{Locale: "en-GB", Description: name}}
+	}
+}
+
+// withBookings gives each booking to the home or away team by its TeamId.
+func withBookings(bookings ...fifa.BookingResponse) matchOption {
+	return func(m *fifa.MatchResponse) {
+		for _, b := range bookings {
+			if b.TeamId == m.HomeTeam.Id {
+				m.HomeTeam.Bookings = append(m.HomeTeam.Bookings, b)
+			} else {
+				m.AwayTeam.Bookings = append(m.AwayTeam.Bookings, b)
+			}
+		}
+	}
+}
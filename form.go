@@ -0,0 +1,173 @@
+package go_fifa
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+const defaultFormLast = 5
+
+type GetTeamFormOptions struct {
+	TeamId        string
+	CompetitionId string
+	SeasonId      string
+	// Last is the number of completed matches, defaulting to 5.
+	Last int
+}
+
+type FormMatch struct {
+	MatchId       string
+	Date          time.Time
+	CompetitionId string
+	Competition   string
+	OpponentId    string
+	Opponent      string
+	Home          bool
+	Scored        int
+	Conceded      int
+	// Result treats matches decided on penalties as draws, with Penalties
+	// set and WonOnPenalties telling who went through.
+	Result         MatchResult
+	Penalties      bool
+	WonOnPenalties bool
+}
+
+type TeamForm struct {
+	TeamId string
+	// Matches are the last completed matches, most recent first.
+	Matches         []FormMatch
+	Wins            int
+	Draws           int
+	Losses          int
+	GoalsScored     int
+	GoalsConceded   int
+	AverageScored   float64
+	AverageConceded float64
+	CleanSheets     int
+	// Streak is the run of identical results leading up to the last match.
+	Streak       int
+	StreakResult MatchResult
+	// Unbeaten and Winless are the current runs without a loss and without a
+	// win.
+	Unbeaten int
+	Winless  int
+}
+
+// String returns the results, most recent first, e.g. "W W D L W".
+func (f TeamForm) String() string {
+	results := make([]string, len(f.Matches))
+	for i, m := range f.Matches {
+		results[i] = string(m.Result)
+	}
+	return strings.Join(results, " ")
+}
+
+// NewTeamForm summarizes the last completed matches of a team.
+func NewTeamForm(teamId string, matches []MatchResponse, last int) *TeamForm {
+	if last <= 0 {
+		last = defaultFormLast
+	}
+	var played []MatchResponse
+	for _, m := range matches {
+		if m.Status != PLAYED {
+			continue
+		}
+		if _, _, ok := teamScore(&m, teamId); ok {
+			played = append(played, m)
+		}
+	}
+	sort.SliceStable(played, func(i, j int) bool {
+		return played[i].Date.After(played[j].Date)
+	})
+	if len(played) > last {
+		played = played[:last]
+	}
+
+	f := &TeamForm{TeamId: teamId}
+	streakOpen, unbeatenOpen, winlessOpen := true, true, true
+	for i := range played {
+		m := &played[i]
+		scored, conceded, _ := teamScore(m, teamId)
+		result, shootout := teamResult(m, teamId)
+		opponent := &m.AwayTeam
+		if m.AwayTeam.Id == teamId {
+			opponent = &m.HomeTeam
+		}
+		fm := FormMatch{
+			MatchId:        m.Id,
+			Date:           m.Date,
+			CompetitionId:  m.CompetitionId,
+			OpponentId:     opponent.Id,
+			Home:           m.HomeTeam.Id == teamId,
+			Scored:         scored,
+			Conceded:       conceded,
+			Result:         result,
+			Penalties:      result == ResultDraw && (m.HomeTeamPenaltyScore > 0 || m.AwayTeamPenaltyScore > 0),
+			WonOnPenalties: shootout,
+		}
+		if len(m.Competition) > 0 {
+			fm.Competition = m.Competition[0].Description
+		}
+		if len(opponent.Name) > 0 {
+			fm.Opponent = opponent.Name[0].Description
+		}
+		f.Matches = append(f.Matches, fm)
+
+		switch result {
+		case ResultWin:
+			f.Wins++
+		case ResultDraw:
+			f.Draws++
+		case ResultLoss:
+			f.Losses++
+		}
+		f.GoalsScored += scored
+		f.GoalsConceded += conceded
+		if conceded == 0 {
+			f.CleanSheets++
+		}
+
+		if i == 0 {
+			f.StreakResult = result
+		}
+		if streakOpen && result == f.StreakResult {
+			f.Streak++
+		} else {
+			streakOpen = false
+		}
+		if unbeatenOpen && result != ResultLoss {
+			f.Unbeaten++
+		} else {
+			unbeatenOpen = false
+		}
+		if winlessOpen && result != ResultWin {
+			f.Winless++
+		} else {
+			winlessOpen = false
+		}
+	}
+	if n := len(f.Matches); n > 0 {
+		f.AverageScored = float64(f.GoalsScored) / float64(n)
+		f.AverageConceded = float64(f.GoalsConceded) / float64(n)
+	}
+	return f
+}
+
+// GetTeamForm returns the form of a team over its last completed matches,
+// paging through its whole calendar so the latest results are not cut off.
+func (c *Client) GetTeamForm(opts *GetTeamFormOptions) (*TeamForm, error) {
+	if opts.TeamId == "" {
+		return nil, errors.New("teamId is required but was not provided")
+	}
+	matches, err := c.getAllTeamMatches(&teamCalendarOptions{
+		TeamId:        opts.TeamId,
+		CompetitionId: opts.CompetitionId,
+		SeasonId:      opts.SeasonId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return NewTeamForm(opts.TeamId, matches, opts.Last), nil
+}
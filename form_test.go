@@ -0,0 +1,101 @@
+package go_fifa_test
+
+import (
+	"testing"
+	"time"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func formStub() *stubHTTPClient {
+	return &stubHTTPClient{responses: map[string]stubResponse{
+		"/calendar/matches?Count=500&IdTeam=ARG": {Body: `{"ContinuationToken":"page2","Results":[
+			{"IdMatch":"1","MatchStatus":0,"Date":"2022-11-22T00:00:00Z","HomeTeam":{"TeamId":"ARG","Score":1},"AwayTeam":{"TeamId":"KSA","Score":2}},
+			{"IdMatch":"2","MatchStatus":0,"Date":"2022-11-26T00:00:00Z","HomeTeam":{"TeamId":"ARG","Score":2},"AwayTeam":{"TeamId":"MEX","Score":0}},
+			{"IdMatch":"3","MatchStatus":0,"Date":"2022-11-30T00:00:00Z","HomeTeam":{"TeamId":"POL","Score":0},"AwayTeam":{"TeamId":"ARG","Score":2}},
+			{"IdMatch":"4","MatchStatus":0,"Date":"2022-12-03T00:00:00Z","HomeTeam":{"TeamId":"ARG","Score":2},"AwayTeam":{"TeamId":"AUS","Score":1}}
+		]}`},
+		"/calendar/matches?ContinuationToken=page2&Count=500&IdTeam=ARG": {Body: `{"ContinuationToken":"page2","Results":[
+			{"IdMatch":"5","MatchStatus":0,"Date":"2022-12-09T00:00:00Z","Winner":"ARG","HomeTeamPenaltyScore":2,"AwayTeamPenaltyScore":4,"HomeTeam":{"TeamId":"NED","Score":2},"AwayTeam":{"TeamId":"ARG","Score":2}},
+			{"IdMatch":"6","MatchStatus":0,"Date":"2022-12-13T00:00:00Z","CompetitionName":[{"Locale":"en-GB","Description":"FIFA World Cup"}],"HomeTeam":{"TeamId":"ARG","Score":3},"AwayTeam":{"TeamId":"CRO","Score":0,"TeamName":[{"Locale":"en-GB","Description":"Croatia"}]}},
+			{"IdMatch":"7","MatchStatus":1,"Date":"2022-12-18T00:00:00Z","HomeTeam":{"TeamId":"ARG"},"AwayTeam":{"TeamId":"FRA"}}
+		]}`},
+	}}
+}
+
+func TestGetTeamForm(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: formStub()}
+	form, err := client.GetTeamForm(&fifa.GetTeamFormOptions{TeamId: "ARG"})
+	if ok := assert.Nil(t, err, "expected no error with GetTeamForm, got: %s", err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "W D W W W", form.String(), "the latest results are on the second page of the calendar")
+	assert.Equal(t, 4, form.Wins)
+	assert.Equal(t, 1, form.Draws)
+	assert.Equal(t, 0, form.Losses)
+	assert.Equal(t, 11, form.GoalsScored)
+	assert.Equal(t, 3, form.GoalsConceded)
+	assert.InDelta(t, 2.2, form.AverageScored, 1e-9)
+	assert.InDelta(t, 0.6, form.AverageConceded, 1e-9)
+	assert.Equal(t, 3, form.CleanSheets)
+	assert.Equal(t, fifa.ResultWin, form.StreakResult)
+	assert.Equal(t, 1, form.Streak)
+	assert.Equal(t, 5, form.Unbeaten)
+	assert.Equal(t, 0, form.Winless)
+
+	latest := form.Matches[0]
+	assert.Equal(t, "6", latest.MatchId)
+	assert.Equal(t, "Croatia", latest.Opponent)
+	assert.Equal(t, "FIFA World Cup", latest.Competition)
+	assert.True(t, latest.Home)
+
+	shootout := form.Matches[1]
+	assert.Equal(t, fifa.ResultDraw, shootout.Result)
+	assert.True(t, shootout.Penalties)
+	assert.True(t, shootout.WonOnPenalties)
+	assert.False(t, shootout.Home)
+}
+
+func TestNewTeamFormStreaks(t *testing.T) {
+	t.Parallel()
+	matches := []fifa.MatchResponse{
+		testMatch("1", "A", 0, "B", 1, playedOnDay(time.December, 1)),
+		testMatch("2", "A", 1, "C", 1, playedOnDay(time.December, 2)),
+		testMatch("3", "D", 0, "A", 0, playedOnDay(time.December, 3)),
+	}
+	form := fifa.NewTeamForm("A", matches, 10)
+	assert.Equal(t, "D D L", form.String())
+	assert.Equal(t, fifa.ResultDraw, form.StreakResult)
+	assert.Equal(t, 2, form.Streak)
+	assert.Equal(t, 2, form.Unbeaten)
+	assert.Equal(t, 3, form.Winless)
+	assert.Equal(t, 1, form.CleanSheets)
+}
+
+func TestGetTeamFormQuery(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{responses: map[string]stubResponse{
+		"/calendar/matches": {Body: `{"Results":[]}`},
+	}}
+	client := fifa.Client{Client: stub}
+	_, err := client.GetTeamForm(&fifa.GetTeamFormOptions{TeamId: "ARG", CompetitionId: "17", SeasonId: "255711"})
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Equal(t, 1, stub.requestCount()); !ok {
+		t.FailNow()
+	}
+	query := stub.requests[0].URL.Query()
+	assert.Equal(t, "ARG", query.Get("IdTeam"))
+	assert.Equal(t, "17", query.Get("IdCompetition"))
+	assert.Equal(t, "255711", query.Get("IdSeason"))
+}
+
+func TestGetTeamFormRequiresTeam(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: formStub()}
+	_, err := client.GetTeamForm(&fifa.GetTeamFormOptions{})
+	assert.NotNil(t, err)
+}
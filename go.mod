@@ -7,4 +7,5 @@ require (
 	github.com/google/go-querystring v1.1.0
 	github.com/google/uuid v1.3.0
 	github.com/stretchr/testify v1.7.0
+	golang.org/x/text v0.3.7
 )
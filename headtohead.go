@@ -0,0 +1,219 @@
+package go_fifa
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+const defaultHeadToHeadLast = 5
+
+type GetHeadToHeadOptions struct {
+	CompetitionId string
+	From          time.Time
+	To            time.Time
+	// Gender limits the meetings to men's or women's teams when set. Both
+	// teams of a meeting are checked, as either may lack a gender.
+	Gender Gender
+	// Last is the number of most recent meetings in Form, defaulting to 5.
+	Last int
+}
+
+type teamCalendarOptions struct {
+	Count             int       `url:"Count"`
+	TeamId            string    `url:"IdTeam"`
+	CompetitionId     string    `url:"IdCompetition,omitempty"`
+	SeasonId          string    `url:"IdSeason,omitempty"`
+	From              time.Time `url:"from,omitempty"`
+	To                time.Time `url:"to,omitempty"`
+	ContinuationToken string    `url:"ContinuationToken,omitempty"`
+}
+
+type HeadToHeadRecord struct {
+	TeamAId string
+	TeamBId string
+	// Matches are the finished meetings in date order and Upcoming the ones
+	// still to be played.
+	Matches    []MatchResponse
+	Upcoming   []MatchResponse
+	Played     int
+	TeamAWins  int
+	Draws      int
+	TeamBWins  int
+	TeamAGoals int
+	TeamBGoals int
+	// Draws include matches decided on penalties, whose winners are counted
+	// in the shoot-out wins.
+	TeamAShootoutWins int
+	TeamBShootoutWins int
+	// TeamABiggestWin and TeamBBiggestWin are the meetings each team won by
+	// the widest margin, the most recent first on equal margins.
+	TeamABiggestWin *MatchResponse
+	TeamBBiggestWin *MatchResponse
+	// Form holds the results of the last meetings from team A's point of
+	// view, most recent first.
+	Form []MatchResult
+}
+
+type MatchResult string
+
+const (
+	ResultWin  MatchResult = "W"
+	ResultDraw MatchResult = "D"
+	ResultLoss MatchResult = "L"
+)
+
+// teamScore returns the goals scored and conceded by a team in a match and
+// whether it took part in it.
+func teamScore(m *MatchResponse, teamId string) (int, int, bool) {
+	switch teamId {
+	case m.HomeTeam.Id:
+		return m.HomeTeam.Score, m.AwayTeam.Score, true
+	case m.AwayTeam.Id:
+		return m.AwayTeam.Score, m.HomeTeam.Score, true
+	}
+	return 0, 0, false
+}
+
+// teamResult returns the result of a match from a team's point of view. A
+// match decided on penalties is a draw; shootout reports whether the team won
+// the shoot-out.
+func teamResult(m *MatchResponse, teamId string) (result MatchResult, shootout bool) {
+	scored, conceded, _ := teamScore(m, teamId)
+	switch {
+	case scored > conceded:
+		return ResultWin, false
+	case scored < conceded:
+		return ResultLoss, false
+	}
+	return ResultDraw, m.WinnerId == teamId && (m.HomeTeamPenaltyScore > 0 || m.AwayTeamPenaltyScore > 0)
+}
+
+// hasGender reports whether a team may be of the given gender. Teams and
+// filters without a gender match any.
+func hasGender(team *TeamResponse, gender Gender) bool {
+	return gender == 0 || team.Gender == 0 || team.Gender == gender
+}
+
+// NewHeadToHead builds the record between two teams from a set of matches,
+// keeping the meetings that match the options.
+func NewHeadToHead(teamA string, teamB string, matches []MatchResponse, opts *GetHeadToHeadOptions) *HeadToHeadRecord {
+	o := GetHeadToHeadOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Last <= 0 {
+		o.Last = defaultHeadToHeadLast
+	}
+	h := &HeadToHeadRecord{TeamAId: teamA, TeamBId: teamB}
+	seen := map[string]bool{}
+	for _, m := range matches {
+		if m.Id != "" && seen[m.Id] {
+			continue
+		}
+		seen[m.Id] = true
+		if !(m.HomeTeam.Id == teamA && m.AwayTeam.Id == teamB || m.HomeTeam.Id == teamB && m.AwayTeam.Id == teamA) {
+			continue
+		}
+		if o.CompetitionId != "" && m.CompetitionId != o.CompetitionId {
+			continue
+		}
+		if !o.From.IsZero() && m.Date.Before(o.From) || !o.To.IsZero() && m.Date.After(o.To) {
+			continue
+		}
+		if !hasGender(&m.HomeTeam, o.Gender) || !hasGender(&m.AwayTeam, o.Gender) {
+			continue
+		}
+		switch m.Status {
+		case PLAYED:
+			h.Matches = append(h.Matches, m)
+		case TO_BE_PLAYED, LIVE:
+			h.Upcoming = append(h.Upcoming, m)
+		}
+	}
+	for _, list := range [][]MatchResponse{h.Matches, h.Upcoming} {
+		sort.SliceStable(list, func(i, j int) bool {
+			return list[i].Date.Before(list[j].Date)
+		})
+	}
+
+	var biggestA, biggestB int
+	for i := range h.Matches {
+		m := &h.Matches[i]
+		scored, conceded, _ := teamScore(m, teamA)
+		h.Played++
+		h.TeamAGoals += scored
+		h.TeamBGoals += conceded
+		result, shootout := teamResult(m, teamA)
+		switch result {
+		case ResultWin:
+			h.TeamAWins++
+			if scored-conceded >= biggestA {
+				biggestA, h.TeamABiggestWin = scored-conceded, m
+			}
+		case ResultLoss:
+			h.TeamBWins++
+			if conceded-scored >= biggestB {
+				biggestB, h.TeamBBiggestWin = conceded-scored, m
+			}
+		default:
+			h.Draws++
+			if shootout {
+				h.TeamAShootoutWins++
+			} else if _, bShootout := teamResult(m, teamB); bShootout {
+				h.TeamBShootoutWins++
+			}
+		}
+	}
+	for i := len(h.Matches) - 1; i >= 0 && len(h.Form) < o.Last; i-- {
+		result, _ := teamResult(&h.Matches[i], teamA)
+		h.Form = append(h.Form, result)
+	}
+	return h
+}
+
+func (c *Client) getAllTeamMatches(opts *teamCalendarOptions) ([]MatchResponse, error) {
+	if opts.Count == 0 {
+		opts.Count = 500
+	}
+	pageOptions := *opts
+	var matches []MatchResponse
+	err := getAllPages(&pageOptions.ContinuationToken, func() (string, int, error) {
+		var respData CurrentMatchesResponse
+		if _, err := c.get("/calendar/matches", &respData, &pageOptions); err != nil {
+			return "", 0, err
+		}
+		matches = append(matches, respData.Results...)
+		return respData.ContinuationToken, len(respData.Results), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
+// GetHeadToHead returns the record between two teams, paging through the
+// calendar of both so meetings missing from one side are still found.
+func (c *Client) GetHeadToHead(teamA string, teamB string, opts *GetHeadToHeadOptions) (*HeadToHeadRecord, error) {
+	if teamA == "" || teamB == "" {
+		return nil, errors.New("teamId is required but was not provided")
+	}
+	o := GetHeadToHeadOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	var matches []MatchResponse
+	for _, teamId := range []string{teamA, teamB} {
+		m, err := c.getAllTeamMatches(&teamCalendarOptions{
+			TeamId:        teamId,
+			CompetitionId: o.CompetitionId,
+			From:          o.From,
+			To:            o.To,
+		})
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, m...)
+	}
+	return NewHeadToHead(teamA, teamB, matches, &o), nil
+}
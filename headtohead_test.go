@@ -0,0 +1,131 @@
+package go_fifa_test
+
+import (
+	"testing"
+	"time"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func headToHeadStub() *stubHTTPClient {
+	return &stubHTTPClient{responses: map[string]stubResponse{
+		"/calendar/matches?Count=500&IdTeam=ARG": {Body: `{"ContinuationToken":"page2","Results":[
+			{"IdMatch":"1","IdCompetition":"17","MatchStatus":0,"Date":"2014-07-01T00:00:00Z","HomeTeam":{"TeamId":"ARG","Score":3,"Gender":1},"AwayTeam":{"TeamId":"FRA","Score":0,"Gender":1}},
+			{"IdMatch":"9","IdCompetition":"17","MatchStatus":0,"Date":"2015-01-01T00:00:00Z","HomeTeam":{"TeamId":"ARG","Score":1,"Gender":1},"AwayTeam":{"TeamId":"BRA","Score":0,"Gender":1}}
+		]}`},
+		"/calendar/matches?ContinuationToken=page2&Count=500&IdTeam=ARG": {Body: `{"Results":[
+			{"IdMatch":"2","IdCompetition":"17","MatchStatus":0,"Date":"2018-06-30T00:00:00Z","HomeTeam":{"TeamId":"FRA","Score":4,"Gender":1},"AwayTeam":{"TeamId":"ARG","Score":3,"Gender":1}},
+			{"IdMatch":"3","IdCompetition":"17","MatchStatus":0,"Date":"2022-12-18T00:00:00Z","Winner":"ARG","HomeTeamPenaltyScore":4,"AwayTeamPenaltyScore":2,"HomeTeam":{"TeamId":"ARG","Score":3,"Gender":1},"AwayTeam":{"TeamId":"FRA","Score":3,"Gender":1}}
+		]}`},
+		"/calendar/matches?Count=500&IdTeam=FRA": {Body: `{"Results":[
+			{"IdMatch":"2","IdCompetition":"17","MatchStatus":0,"Date":"2018-06-30T00:00:00Z","HomeTeam":{"TeamId":"FRA","Score":4,"Gender":1},"AwayTeam":{"TeamId":"ARG","Score":3,"Gender":1}},
+			{"IdMatch":"4","IdCompetition":"99","MatchStatus":0,"Date":"2009-02-11T00:00:00Z","HomeTeam":{"TeamId":"FRA","Score":0,"Gender":1},"AwayTeam":{"TeamId":"ARG","Score":2,"Gender":1}},
+			{"IdMatch":"5","IdCompetition":"99","MatchStatus":1,"Date":"2030-01-01T00:00:00Z","HomeTeam":{"TeamId":"FRA","Gender":1},"AwayTeam":{"TeamId":"ARG","Gender":1}}
+		]}`},
+	}}
+}
+
+func TestGetHeadToHead(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: headToHeadStub()}
+	h, err := client.GetHeadToHead("ARG", "FRA", &fifa.GetHeadToHeadOptions{Last: 3})
+	if ok := assert.Nil(t, err, "expected no error with GetHeadToHead, got: %s", err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, 4, h.Played, "meetings from both calendars should be merged once")
+	assert.Equal(t, 2, h.TeamAWins)
+	assert.Equal(t, 1, h.Draws)
+	assert.Equal(t, 1, h.TeamBWins)
+	assert.Equal(t, 1, h.TeamAShootoutWins)
+	assert.Equal(t, 11, h.TeamAGoals)
+	assert.Equal(t, 7, h.TeamBGoals)
+	if ok := assert.NotNil(t, h.TeamABiggestWin); ok {
+		assert.Equal(t, "1", h.TeamABiggestWin.Id)
+	}
+	if ok := assert.NotNil(t, h.TeamBBiggestWin); ok {
+		assert.Equal(t, "2", h.TeamBBiggestWin.Id)
+	}
+	assert.Equal(t, []fifa.MatchResult{fifa.ResultDraw, fifa.ResultLoss, fifa.ResultWin}, h.Form)
+	if ok := assert.Len(t, h.Upcoming, 1); ok {
+		assert.Equal(t, "5", h.Upcoming[0].Id)
+	}
+	assert.Equal(t, "4", h.Matches[0].Id)
+}
+
+func TestNewHeadToHeadFilters(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: headToHeadStub()}
+	all, err := client.GetHeadToHead("FRA", "ARG", nil)
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	matches := append(all.Matches, all.Upcoming...)
+	h := fifa.NewHeadToHead("FRA", "ARG", matches, &fifa.GetHeadToHeadOptions{
+		CompetitionId: "17",
+		From:          time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	assert.Equal(t, 2, h.Played)
+	assert.Equal(t, 1, h.TeamAWins)
+	assert.Equal(t, 1, h.TeamBShootoutWins)
+	assert.Empty(t, h.Upcoming)
+
+	women := fifa.NewHeadToHead("FRA", "ARG", matches, &fifa.GetHeadToHeadOptions{Gender: fifa.FEMALE})
+	assert.Equal(t, 0, women.Played)
+
+	awayOnly := testMatch("6", "FRA", 1, "ARG", 0)
+	awayOnly.AwayTeam.Gender = fifa.FEMALE
+	women = fifa.NewHeadToHead("FRA", "ARG", []fifa.MatchResponse{awayOnly}, &fifa.GetHeadToHeadOptions{Gender: fifa.FEMALE})
+	assert.Equal(t, 1, women.Played, "the away team's gender should be used when the home team has none")
+	men := fifa.NewHeadToHead("FRA", "ARG", []fifa.MatchResponse{awayOnly}, &fifa.GetHeadToHeadOptions{Gender: fifa.MALE})
+	assert.Equal(t, 0, men.Played, "the away team's gender should be checked too")
+}
+
+func TestGetHeadToHeadQuery(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{responses: map[string]stubResponse{
+		"/calendar/matches": {Body: `{"Results":[]}`},
+	}}
+	client := fifa.Client{Client: stub}
+	_, err := client.GetHeadToHead("ARG", "FRA", &fifa.GetHeadToHeadOptions{
+		CompetitionId: "17",
+		From:          time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Equal(t, 2, stub.requestCount()); !ok {
+		t.FailNow()
+	}
+	query := stub.requests[1].URL.Query()
+	assert.Equal(t, "FRA", query.Get("IdTeam"))
+	assert.Equal(t, "17", query.Get("IdCompetition"))
+	assert.Equal(t, "2015-01-01T00:00:00Z", query.Get("from"))
+	assert.Equal(t, "", query.Get("to"))
+}
+
+func TestGetHeadToHeadRequiresTeams(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: headToHeadStub()}
+	_, err := client.GetHeadToHead("ARG", "", nil)
+	assert.NotNil(t, err)
+}
+
+func TestNewHeadToHeadShootoutWinsSymmetric(t *testing.T) {
+	t.Parallel()
+	aggregate := testMatch("1", "A", 1, "B", 1)
+	aggregate.WinnerId = "B"
+	shootout := testMatch("2", "B", 0, "A", 0)
+	shootout.WinnerId = "B"
+	shootout.HomeTeamPenaltyScore = 5
+	shootout.AwayTeamPenaltyScore = 4
+	matches := []fifa.MatchResponse{aggregate, shootout}
+
+	ab := fifa.NewHeadToHead("A", "B", matches, nil)
+	ba := fifa.NewHeadToHead("B", "A", matches, nil)
+	assert.Equal(t, 2, ab.Draws)
+	assert.Equal(t, 0, ab.TeamAShootoutWins)
+	assert.Equal(t, 1, ab.TeamBShootoutWins, "a draw won on aggregate is not a shoot-out win")
+	assert.Equal(t, ab.TeamBShootoutWins, ba.TeamAShootoutWins)
+	assert.Equal(t, ab.TeamAShootoutWins, ba.TeamBShootoutWins)
+}
@@ -0,0 +1,261 @@
+package go_fifa
+
+import (
+	"math"
+	"sort"
+)
+
+const (
+	defaultRedCardPenalty = 0.7
+	defaultRedCardBonus   = 1.2
+	defaultMinRemaining   = 1
+)
+
+// AddedTime holds the minutes of added time expected at the end of each half
+// and of each half of extra time.
+type AddedTime struct {
+	FirstHalf  float64
+	SecondHalf float64
+	ExtraTime  float64
+}
+
+// DefaultAddedTime is the added time InPlay expects when none is set.
+var DefaultAddedTime = AddedTime{FirstHalf: 2, SecondHalf: 5, ExtraTime: 1}
+
+type InPlayOptions struct {
+	// RedCardPenalty multiplies a team's scoring rate for every player it
+	// has had sent off. Defaults to 0.7.
+	RedCardPenalty float64
+	// RedCardBonus multiplies a team's scoring rate for every player its
+	// opponent has had sent off. Defaults to 1.2.
+	RedCardBonus float64
+	// AddedTime is the added time expected in every match, defaulting to
+	// DefaultAddedTime. Point it at a zero AddedTime to expect none.
+	AddedTime *AddedTime
+	// MinRemaining is the number of minutes a running match is assumed to
+	// have left once it is past its expected added time, so it is only
+	// decided when its period ends. Defaults to 1.
+	MinRemaining float64
+}
+
+type WinProbability struct {
+	Minute    MatchMinute
+	HomeScore int
+	AwayScore int
+	HomeWin   float64
+	Draw      float64
+	AwayWin   float64
+}
+
+// InPlayState is the state of a match the probabilities are computed from.
+type InPlayState struct {
+	Minute    MatchMinute
+	HomeScore int
+	AwayScore int
+	HomeReds  int
+	AwayReds  int
+	// Finished marks a match with no time left to play.
+	Finished bool
+}
+
+func (o *InPlayOptions) withDefaults() InPlayOptions {
+	out := InPlayOptions{}
+	if o != nil {
+		out = *o
+	}
+	if out.RedCardPenalty <= 0 {
+		out.RedCardPenalty = defaultRedCardPenalty
+	}
+	if out.RedCardBonus <= 0 {
+		out.RedCardBonus = defaultRedCardBonus
+	}
+	if out.AddedTime == nil {
+		added := DefaultAddedTime
+		out.AddedTime = &added
+	}
+	if out.MinRemaining <= 0 {
+		out.MinRemaining = defaultMinRemaining
+	}
+	return out
+}
+
+// remainingShare returns the share of a match's expected playing time, normal
+// time plus the added time expected at the end of both halves, still to be
+// played at the given minute. Matches in extra time play on to 120 minutes
+// and their added time. A period running past its expected added time keeps
+// MinRemaining minutes to play until it ends.
+func remainingShare(minute MatchMinute, o InPlayOptions) float64 {
+	added := *o.AddedTime
+	played := float64(minute.Minute + minute.Added)
+	// left returns the minutes to play in a running period that nominally
+	// ends at end and is followed by rest minutes in later periods.
+	left := func(end float64, extra float64, rest float64) float64 {
+		return math.Max(end+extra-played, o.MinRemaining) + rest
+	}
+	var remaining float64
+	switch minute.Period {
+	case FIRST:
+		remaining = left(45, added.FirstHalf, 45+added.SecondHalf)
+	case HALF_TIME:
+		remaining = 45 + added.SecondHalf
+	case SECOND:
+		remaining = left(90, added.SecondHalf, 0)
+	case FIRST_EXTRA:
+		remaining = left(105, added.ExtraTime, 15+added.ExtraTime)
+	case EXTRA_HALF_TIME:
+		remaining = 15 + added.ExtraTime
+	case SECOND_EXTRA:
+		remaining = left(120, added.ExtraTime, 0)
+	case END_OF_SECOND, END_OF_EXTRA, SHOOTOUT:
+		return 0
+	default:
+		remaining = math.Max(90+added.FirstHalf+added.SecondHalf-played, 0)
+	}
+	return remaining / (90 + added.FirstHalf + added.SecondHalf)
+}
+
+// InPlay returns the probabilities of the result at the end of play given the
+// state of the match, scaling the pre-match expected goals by the time left
+// and the players sent off. A draw in a knockout match means the teams are
+// level at the end of play.
+func (p Prediction) InPlay(state InPlayState, opts *InPlayOptions) WinProbability {
+	o := opts.withDefaults()
+	wp := WinProbability{
+		Minute:    state.Minute,
+		HomeScore: state.HomeScore,
+		AwayScore: state.AwayScore,
+	}
+	share := 0.0
+	if !state.Finished {
+		share = remainingShare(state.Minute, o)
+	}
+	if share <= 0 {
+		switch {
+		case state.HomeScore > state.AwayScore:
+			wp.HomeWin = 1
+		case state.HomeScore < state.AwayScore:
+			wp.AwayWin = 1
+		default:
+			wp.Draw = 1
+		}
+		return wp
+	}
+	home := p.HomeExpectedGoals * share * pow(o.RedCardPenalty, state.HomeReds) * pow(o.RedCardBonus, state.AwayReds)
+	away := p.AwayExpectedGoals * share * pow(o.RedCardPenalty, state.AwayReds) * pow(o.RedCardBonus, state.HomeReds)
+	maxGoals := len(p.Scores) - 1
+	rest := NewPrediction(p.HomeTeamId, p.AwayTeamId, home, away, maxGoals)
+	lead := state.HomeScore - state.AwayScore
+	for h := range rest.Scores {
+		for a, v := range rest.Scores[h] {
+			switch diff := lead + h - a; {
+			case diff > 0:
+				wp.HomeWin += v
+			case diff < 0:
+				wp.AwayWin += v
+			default:
+				wp.Draw += v
+			}
+		}
+	}
+	return wp
+}
+
+func pow(x float64, n int) float64 {
+	out := 1.0
+	for i := 0; i < n; i++ {
+		out *= x
+	}
+	return out
+}
+
+// countReds counts the players of a team sent off, directly or for a second
+// yellow card.
+func countReds(bookings []BookingResponse) int {
+	reds := 0
+	for _, b := range bookings {
+		if b.Card == RED || b.Card == YELLOW_RED {
+			reds++
+		}
+	}
+	return reds
+}
+
+// InPlayProbability returns the probabilities of a match's result from its
+// current score, period, match time and bookings.
+func InPlayProbability(pre *Prediction, m *MatchResponse, opts *InPlayOptions) WinProbability {
+	state := InPlayState{
+		HomeScore: m.HomeTeam.Score,
+		AwayScore: m.AwayTeam.Score,
+		HomeReds:  countReds(m.HomeTeam.Bookings),
+		AwayReds:  countReds(m.AwayTeam.Bookings),
+		Finished:  m.Status == PLAYED,
+	}
+	switch m.Status {
+	case LIVE:
+		minute, _ := ParseMatchMinute(m.MatchTime)
+		state.Minute = minute.WithPeriod(m.Period)
+		if state.Minute.IsZero() {
+			state.Minute = MatchMinute{Period: m.Period}
+		}
+	case TO_BE_PLAYED:
+		state = InPlayState{Minute: MatchMinute{Period: FIRST}}
+	}
+	return pre.InPlay(state, opts)
+}
+
+// ReplayWinProbability replays a match's timeline and returns the
+// probabilities at kick-off, at the end of every minute and after every goal
+// and sending off, in playing order.
+func ReplayWinProbability(pre *Prediction, timeline *Timeline, opts *InPlayOptions) []WinProbability {
+	points := []MatchMinute{{Period: FIRST}}
+	last := 90
+	for _, e := range timeline.Entries {
+		if e.Minute.Period == FIRST_EXTRA || e.Minute.Period == SECOND_EXTRA {
+			last = 120
+		}
+	}
+	for minute := 1; minute <= last; minute++ {
+		period := FIRST
+		switch {
+		case minute > 105:
+			period = SECOND_EXTRA
+		case minute > 90:
+			period = FIRST_EXTRA
+		case minute > 45:
+			period = SECOND
+		}
+		points = append(points, MatchMinute{Minute: minute, Period: period})
+	}
+	for _, e := range timeline.Entries {
+		switch e.Event.Type {
+		case RedCard, DoubleYellow:
+		default:
+			if !e.IsGoal() {
+				continue
+			}
+		}
+		if !e.Minute.IsZero() {
+			points = append(points, e.Minute)
+		}
+	}
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].Compare(points[j]) < 0
+	})
+
+	var series []WinProbability
+	for i, minute := range points {
+		if i > 0 && minute.Compare(points[i-1]) == 0 {
+			continue
+		}
+		snap := timeline.At(minute)
+		state := InPlayState{
+			Minute:    minute,
+			HomeScore: snap.HomeScore,
+			AwayScore: snap.AwayScore,
+			HomeReds:  snap.Cards[timeline.HomeTeamId].Red,
+			AwayReds:  snap.Cards[timeline.AwayTeamId].Red,
+		}
+		series = append(series, pre.InPlay(state, opts))
+	}
+	return series
+}
@@ -0,0 +1,109 @@
+package go_fifa_test
+
+import (
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPredictionInPlay(t *testing.T) {
+	t.Parallel()
+	pre := fifa.NewPrediction("H", "A", 1.4, 1.1, 10)
+
+	kickoff := pre.InPlay(fifa.InPlayState{Minute: fifa.MatchMinute{Period: fifa.FIRST}}, nil)
+	assert.InDelta(t, pre.HomeWin, kickoff.HomeWin, 1e-9)
+	assert.InDelta(t, pre.Draw, kickoff.Draw, 1e-9)
+
+	early := pre.InPlay(fifa.InPlayState{Minute: minute(t, "20'"), HomeScore: 1}, nil)
+	late := pre.InPlay(fifa.InPlayState{Minute: minute(t, "85'"), HomeScore: 1}, nil)
+	assert.Greater(t, early.HomeWin, pre.HomeWin)
+	assert.Greater(t, late.HomeWin, early.HomeWin)
+	assert.InDelta(t, 1, late.HomeWin+late.Draw+late.AwayWin, 1e-9)
+
+	down := pre.InPlay(fifa.InPlayState{Minute: minute(t, "20'"), HomeScore: 1, HomeReds: 1}, nil)
+	assert.Less(t, down.HomeWin, early.HomeWin, "a sending off should hurt the team")
+
+	halfTime := pre.InPlay(fifa.InPlayState{Minute: fifa.MatchMinute{Period: fifa.HALF_TIME}, AwayScore: 1}, nil)
+	secondHalf := pre.InPlay(fifa.InPlayState{Minute: minute(t, "46'"), AwayScore: 1}, nil)
+	assert.InDelta(t, halfTime.AwayWin, secondHalf.AwayWin, 0.02)
+
+	over := pre.InPlay(fifa.InPlayState{Minute: fifa.MatchMinute{Period: fifa.END_OF_SECOND}, HomeScore: 2, AwayScore: 2}, nil)
+	assert.Equal(t, 1.0, over.Draw)
+}
+
+func TestPredictionInPlayAddedTime(t *testing.T) {
+	t.Parallel()
+	pre := fifa.NewPrediction("H", "A", 1.4, 1.1, 10)
+	level := fifa.InPlayState{Minute: minute(t, "90'+2'"), HomeScore: 1, AwayScore: 1}
+
+	stoppage := pre.InPlay(level, nil)
+	assert.Less(t, stoppage.Draw, 1.0, "a level match in added time can still be won")
+	assert.Greater(t, stoppage.HomeWin, 0.0)
+	assert.Greater(t, stoppage.AwayWin, 0.0)
+	assert.Greater(t, stoppage.Draw, pre.InPlay(fifa.InPlayState{Minute: minute(t, "85'"), HomeScore: 1, AwayScore: 1}, nil).Draw)
+
+	late := level
+	late.Minute = minute(t, "90'+9'")
+	longStoppage := pre.InPlay(late, nil)
+	assert.Less(t, longStoppage.Draw, 1.0, "the match is not decided until the period ends")
+	assert.GreaterOrEqual(t, longStoppage.Draw, stoppage.Draw)
+
+	firstHalf := pre.InPlay(fifa.InPlayState{Minute: minute(t, "45'+3'"), HomeScore: 1}, nil)
+	assert.Less(t, firstHalf.HomeWin, 0.9, "a whole second half is left after first half added time")
+
+	extraTime := pre.InPlay(fifa.InPlayState{Minute: minute(t, "120'+1'").WithPeriod(fifa.SECOND_EXTRA), HomeScore: 1, AwayScore: 1}, nil)
+	assert.Less(t, extraTime.Draw, 1.0)
+
+	none := pre.InPlay(level, &fifa.InPlayOptions{AddedTime: &fifa.AddedTime{}})
+	assert.Greater(t, none.Draw, stoppage.Draw, "expecting no added time leaves only the minimum")
+	assert.Less(t, none.Draw, 1.0)
+}
+
+func TestInPlayProbability(t *testing.T) {
+	t.Parallel()
+	pre := fifa.NewPrediction("H", "A", 1.4, 1.1, 10)
+	m := fifa.MatchResponse{
+		Status:    fifa.LIVE,
+		Period:    fifa.SECOND,
+		MatchTime: "80'",
+		HomeTeam:  fifa.TeamResponse{Id: "H", Score: 1},
+		AwayTeam:  fifa.TeamResponse{Id: "A"},
+	}
+	live := fifa.InPlayProbability(&pre, &m, nil)
+	assert.Greater(t, live.HomeWin, 0.8)
+
+	m.AwayTeam.Bookings = []fifa.BookingResponse{{Card: fifa.RED, PlayerId: "a1"}}
+	assert.Greater(t, fifa.InPlayProbability(&pre, &m, nil).HomeWin, live.HomeWin)
+
+	m.Status = fifa.PLAYED
+	assert.Equal(t, 1.0, fifa.InPlayProbability(&pre, &m, nil).HomeWin)
+
+	m.Status = fifa.TO_BE_PLAYED
+	assert.InDelta(t, pre.HomeWin, fifa.InPlayProbability(&pre, &m, nil).HomeWin, 1e-9)
+}
+
+func TestReplayWinProbability(t *testing.T) {
+	t.Parallel()
+	pre := fifa.NewPrediction("H", "A", 1.2, 1.2, 10)
+	events := &fifa.GetMatchEventsResponse{Events: []fifa.EventResponse{
+		timelineEvent(t, fifa.GoalScore, fifa.FIRST, "30'", "H", "h1", 1, 0),
+		timelineEvent(t, fifa.RedCard, fifa.SECOND, "50'", "A", "a4", 1, 0),
+		timelineEvent(t, fifa.GoalScore, fifa.SECOND, "90'+3'", "A", "a9", 1, 1),
+	}}
+	timeline := fifa.NewTimeline(events, &fifa.TeamResponse{Id: "H"}, &fifa.TeamResponse{Id: "A"})
+	series := fifa.ReplayWinProbability(&pre, timeline, nil)
+	if ok := assert.Len(t, series, 92); !ok {
+		t.FailNow()
+	}
+	assert.InDelta(t, pre.HomeWin, series[0].HomeWin, 1e-9)
+	assert.Greater(t, series[30].HomeWin, series[29].HomeWin, "the goal in the 30th minute")
+	assert.Equal(t, 1, series[30].HomeScore)
+	assert.Greater(t, series[50].HomeWin, series[49].HomeWin, "the sending off in the 50th minute")
+	assert.Greater(t, series[90].HomeWin, 0.9)
+	assert.Less(t, series[90].HomeWin, 1.0, "added time is still to be played at 90 minutes")
+	last := series[len(series)-1]
+	assert.Equal(t, "90'+3'", last.Minute.String())
+	assert.Greater(t, last.Draw, 0.9)
+	assert.Less(t, last.Draw, 1.0)
+}
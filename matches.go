@@ -1,6 +1,8 @@
 package go_fifa
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 )
@@ -26,11 +28,11 @@ type GetTeamMatchesOptions struct {
 	CompetitionId string `url:"IdCompetition"`
 }
 
-type GetMatchDataOptions struct {
-	CompetitionId string
-	SeasonId      string
-	StageId       string
-	MatchId       string
+type GetSeasonMatchesOptions struct {
+	CompetitionId     string `url:"IdCompetition"`
+	SeasonId          string `url:"IdSeason"`
+	Count             int    `url:"Count"`
+	ContinuationToken string `url:"ContinuationToken,omitempty"`
 }
 
 func (c *Client) GetCurrentMatches() ([]MatchResponse, error) {
@@ -84,10 +86,45 @@ func (c *Client) GetTeamMatches(opts *GetTeamMatchesOptions) ([]MatchResponse, e
 	return respData.Results, nil
 }
 
+// GetSeasonMatches returns every match of a season from the calendar,
+// following continuation tokens until all pages have been read.
+func (c *Client) GetSeasonMatches(opts *GetSeasonMatchesOptions) ([]MatchResponse, error) {
+	if opts.CompetitionId == "" {
+		return nil, errors.New("competitionId is required but was not provided")
+	}
+	if opts.SeasonId == "" {
+		return nil, errors.New("seasonId is required but was not provided")
+	}
+	if opts.Count == 0 {
+		opts.Count = 500
+	}
+	pageOptions := *opts
+	var matches []MatchResponse
+	err := getAllPages(&pageOptions.ContinuationToken, func() (string, int, error) {
+		var respData CurrentMatchesResponse
+		if _, err := c.get("/calendar/matches", &respData, &pageOptions); err != nil {
+			return "", 0, err
+		}
+		matches = append(matches, respData.Results...)
+		return respData.ContinuationToken, len(respData.Results), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matches, nil
+}
+
 func (c *Client) GetMatchData(options *GetMatchDataOptions) (MatchDataResponse, error) {
+	return c.getMatchData(context.Background(), options)
+}
+
+func (c *Client) getMatchData(ctx context.Context, options *GetMatchDataOptions) (MatchDataResponse, error) {
+	if err := options.Validate(); err != nil {
+		return MatchDataResponse{}, err
+	}
 	var respData MatchDataResponse
 	url := fmt.Sprintf("/live/football/%s/%s/%s/%s", options.CompetitionId, options.SeasonId, options.StageId, options.MatchId)
-	_, err := c.get(url, &respData, nil)
+	_, err := c.getWithContext(ctx, url, &respData, nil)
 	if err != nil {
 		return MatchDataResponse{}, err
 	}
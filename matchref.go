@@ -0,0 +1,112 @@
+package go_fifa
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// MatchRef identifies a match by the four ids every match endpoint needs. It
+// formats as "competition/season/stage/match".
+type MatchRef struct {
+	CompetitionId string
+	SeasonId      string
+	StageId       string
+	MatchId       string
+}
+
+type GetMatchEventOptions = MatchRef
+
+type GetMatchDataOptions = MatchRef
+
+func (r MatchRef) String() string {
+	return strings.Join([]string{r.CompetitionId, r.SeasonId, r.StageId, r.MatchId}, "/")
+}
+
+func (r MatchRef) Validate() error {
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"competitionId", r.CompetitionId},
+		{"seasonId", r.SeasonId},
+		{"stageId", r.StageId},
+		{"matchId", r.MatchId},
+	}
+	for _, f := range fields {
+		if f.value == "" {
+			return fmt.Errorf("%s is required but not provided", f.name)
+		}
+		if !isRefId(f.value) {
+			return fmt.Errorf("%s %q is not a valid id", f.name, f.value)
+		}
+	}
+	return nil
+}
+
+func isRefId(s string) bool {
+	for _, r := range s {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-' || r == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseMatchRef parses a "competition/season/stage/match" string or a fifa.com
+// match centre URL such as
+// https://www.fifa.com/fifaplus/en/match-centre/match/17/255711/285063/400128082.
+func ParseMatchRef(s string) (MatchRef, error) {
+	s = strings.TrimSpace(s)
+	path := s
+	if strings.Contains(s, "://") {
+		u, err := url.Parse(s)
+		if err != nil {
+			return MatchRef{}, fmt.Errorf("invalid match url: %w", err)
+		}
+		path = u.Path
+	}
+	var parts []string
+	for _, p := range strings.Split(path, "/") {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	if path != s {
+		// Only accept URLs whose path ends in a match segment followed by
+		// exactly four ids, so other fifa.com pages are rejected.
+		var ids []string
+		for i := len(parts) - 1; i >= 0; i-- {
+			if p := parts[i]; p == "match" || p == "match-centre" || p == "match-center" {
+				if len(parts) == i+5 {
+					ids = parts[i+1:]
+				}
+				break
+			}
+		}
+		if ids == nil {
+			return MatchRef{}, fmt.Errorf("not a match url: %q", s)
+		}
+		parts = ids
+	}
+	if len(parts) != 4 {
+		return MatchRef{}, fmt.Errorf("match reference must contain competition, season, stage and match ids: %q", s)
+	}
+	ref := MatchRef{CompetitionId: parts[0], SeasonId: parts[1], StageId: parts[2], MatchId: parts[3]}
+	if err := ref.Validate(); err != nil {
+		return MatchRef{}, err
+	}
+	return ref, nil
+}
+
+func (m MatchResponse) Ref() MatchRef {
+	return MatchRef{CompetitionId: m.CompetitionId, SeasonId: m.SeasonId, StageId: m.StageId, MatchId: m.Id}
+}
+
+func (m MatchDataResponse) Ref() MatchRef {
+	return MatchRef{CompetitionId: m.CompetitionId, SeasonId: m.SeasonId, StageId: m.StageId, MatchId: m.MatchId}
+}
+
+func (e GetMatchEventsResponse) Ref() MatchRef {
+	return MatchRef{CompetitionId: e.CompetitionId, SeasonId: e.SeasonId, StageId: e.StageId, MatchId: e.MatchId}
+}
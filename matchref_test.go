@@ -0,0 +1,67 @@
+package go_fifa_test
+
+import (
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMatchRef(t *testing.T) {
+	t.Parallel()
+	want := fifa.MatchRef{CompetitionId: "17", SeasonId: "255711", StageId: "285063", MatchId: "400128082"}
+	for _, input := range []string{
+		"17/255711/285063/400128082",
+		"https://www.fifa.com/fifaplus/en/match-centre/match/17/255711/285063/400128082",
+		"https://www.fifa.com/en/match-centre/match/17/255711/285063/400128082?tab=lineup",
+	} {
+		ref, err := fifa.ParseMatchRef(input)
+		if ok := assert.Nil(t, err, input); !ok {
+			t.FailNow()
+		}
+		assert.Equal(t, want, ref, input)
+		assert.Equal(t, "17/255711/285063/400128082", ref.String())
+	}
+}
+
+func TestParseMatchRefInvalid(t *testing.T) {
+	t.Parallel()
+	for _, input := range []string{
+		"",
+		"17/255711/285063",
+		"17/255711/285063/400128082/extra",
+		"17/255711/285 063/400128082",
+		"https://www.fifa.com/en/tournaments",
+		"https://www.fifa.com/fifaplus/en/tournaments/mens/worldcup",
+		"https://www.fifa.com/fifaplus/en/articles/messi-argentina-world-cup-final-2022",
+		"https://www.fifa.com/en/match-centre/match/17/255711/285063",
+		"https://www.fifa.com/en/match-centre/match/17/255711/285063/400128082/lineup",
+	} {
+		_, err := fifa.ParseMatchRef(input)
+		assert.NotNil(t, err, input)
+	}
+}
+
+func TestMatchRefValidate(t *testing.T) {
+	t.Parallel()
+	err := fifa.MatchRef{CompetitionId: "17", SeasonId: "255711", StageId: "285063"}.Validate()
+	if ok := assert.NotNil(t, err); !ok {
+		t.FailNow()
+	}
+	assert.Contains(t, err.Error(), "matchId")
+}
+
+func TestMatchResponseRef(t *testing.T) {
+	t.Parallel()
+	m := fifa.MatchResponse{CompetitionId: "17", SeasonId: "s", StageId: "g", Id: "m"}
+	assert.Equal(t, fifa.MatchRef{CompetitionId: "17", SeasonId: "s", StageId: "g", MatchId: "m"}, m.Ref())
+}
+
+func TestGetMatchDataRequiresRef(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{}
+	client := fifa.Client{Client: stub}
+	_, err := client.GetMatchData(&fifa.GetMatchDataOptions{MatchId: "m"})
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, stub.requestCount())
+}
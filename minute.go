@@ -0,0 +1,178 @@
+package go_fifa
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var matchMinutePattern = regexp.MustCompile(`^(\d+)'?(?:\s*\+\s*(\d+)'?)?$`)
+
+// MatchMinute is a parsed match clock reading such as "67'" or "90'+5'".
+type MatchMinute struct {
+	Minute int
+	Added  int
+	Period PeriodEnum
+	raw    string
+	// set marks a minute that was parsed, so "0'" is told apart from no
+	// minute at all.
+	set bool
+}
+
+// ParseMatchMinute parses the API's minute format. The period is inferred from
+// the minute and can be overridden with WithPeriod when it is known.
+func ParseMatchMinute(s string) (MatchMinute, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return MatchMinute{}, nil
+	}
+	parts := matchMinutePattern.FindStringSubmatch(s)
+	if parts == nil {
+		return MatchMinute{}, fmt.Errorf("invalid match minute: %q", s)
+	}
+	minute, _ := strconv.Atoi(parts[1])
+	var added int
+	if parts[2] != "" {
+		added, _ = strconv.Atoi(parts[2])
+	}
+	return MatchMinute{Minute: minute, Added: added, Period: periodForMinute(minute), set: true}, nil
+}
+
+func periodForMinute(minute int) PeriodEnum {
+	switch {
+	case minute <= 45:
+		return FIRST
+	case minute <= 90:
+		return SECOND
+	case minute <= 105:
+		return FIRST_EXTRA
+	}
+	return SECOND_EXTRA
+}
+
+// IsZero reports whether the minute is empty. A parsed "0'" is not.
+func (m MatchMinute) IsZero() bool {
+	return m.Minute == 0 && m.Added == 0 && m.raw == "" && !m.set
+}
+
+// WithPeriod returns the minute with its period set to p, unless p is unknown.
+func (m MatchMinute) WithPeriod(p PeriodEnum) MatchMinute {
+	if p != 0 && !m.IsZero() {
+		m.Period = p
+	}
+	return m
+}
+
+// String returns the minute in its canonical form, "67'" or "90'+5'", which
+// parses back to the same minute. Other accepted spellings such as "90+5" or
+// "105'+1" are normalized; only unrecognised input is returned verbatim.
+func (m MatchMinute) String() string {
+	if m.raw != "" {
+		return m.raw
+	}
+	if m.IsZero() {
+		return ""
+	}
+	if m.Added > 0 {
+		return fmt.Sprintf("%d'+%d'", m.Minute, m.Added)
+	}
+	return fmt.Sprintf("%d'", m.Minute)
+}
+
+// Compare orders minutes by period, minute and added time, returning -1, 0
+// or 1. Stoppage time sorts before the first minute of the next period.
+func (m MatchMinute) Compare(o MatchMinute) int {
+	switch {
+	case m.Period != o.Period:
+		return compareInts(int(m.Period), int(o.Period))
+	case m.Minute != o.Minute:
+		return compareInts(m.Minute, o.Minute)
+	}
+	return compareInts(m.Added, o.Added)
+}
+
+func (m MatchMinute) Before(o MatchMinute) bool {
+	return m.Compare(o) < 0
+}
+
+// Seconds returns the match clock at the start of the minute in seconds, with
+// added time extending the clock past the end of the period. Use Compare to
+// order minutes across periods.
+func (m MatchMinute) Seconds() int {
+	if m.Minute == 0 && m.Added == 0 {
+		return 0
+	}
+	return (m.Minute - 1 + m.Added) * 60
+}
+
+func (m MatchMinute) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON accepts unrecognised formats so a single odd value does not
+// fail a whole response; they are kept verbatim and returned by String.
+func (m *MatchMinute) UnmarshalJSON(data []byte) error {
+	var s *string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == nil {
+		*m = MatchMinute{}
+		return nil
+	}
+	parsed, err := ParseMatchMinute(*s)
+	if err != nil {
+		*m = MatchMinute{raw: *s}
+		return nil
+	}
+	*m = parsed
+	return nil
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func (e *EventResponse) UnmarshalJSON(data []byte) error {
+	type alias EventResponse
+	if err := json.Unmarshal(data, (*alias)(e)); err != nil {
+		return err
+	}
+	e.MatchMinute = e.MatchMinute.WithPeriod(e.Period)
+	return nil
+}
+
+func (b *BookingResponse) UnmarshalJSON(data []byte) error {
+	type alias BookingResponse
+	if err := json.Unmarshal(data, (*alias)(b)); err != nil {
+		return err
+	}
+	b.Minute = b.Minute.WithPeriod(b.Period)
+	return nil
+}
+
+func (g *GoalResponse) UnmarshalJSON(data []byte) error {
+	type alias GoalResponse
+	if err := json.Unmarshal(data, (*alias)(g)); err != nil {
+		return err
+	}
+	g.Minute = g.Minute.WithPeriod(g.Period)
+	return nil
+}
+
+func (s *SubstitutionResponse) UnmarshalJSON(data []byte) error {
+	type alias SubstitutionResponse
+	if err := json.Unmarshal(data, (*alias)(s)); err != nil {
+		return err
+	}
+	s.Minute = s.Minute.WithPeriod(s.Period)
+	return nil
+}
@@ -0,0 +1,106 @@
+package go_fifa_test
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMatchMinute(t *testing.T) {
+	t.Parallel()
+	cases := map[string]fifa.MatchMinute{
+		"":       {},
+		"1'":     {Minute: 1, Period: fifa.FIRST},
+		"45'+2'": {Minute: 45, Added: 2, Period: fifa.FIRST},
+		"46'":    {Minute: 46, Period: fifa.SECOND},
+		"90'+5'": {Minute: 90, Added: 5, Period: fifa.SECOND},
+		"105'+1": {Minute: 105, Added: 1, Period: fifa.FIRST_EXTRA},
+		"118'":   {Minute: 118, Period: fifa.SECOND_EXTRA},
+	}
+	for input, expected := range cases {
+		m, err := fifa.ParseMatchMinute(input)
+		if ok := assert.Nil(t, err, "expected no error parsing %q, got: %s", input, err); !ok {
+			continue
+		}
+		assert.Equal(t, expected.Minute, m.Minute, "unexpected minute for %q", input)
+		assert.Equal(t, expected.Added, m.Added, "unexpected added time for %q", input)
+		assert.Equal(t, expected.Period, m.Period, "unexpected period for %q", input)
+		assert.Equal(t, input == "", m.IsZero(), "unexpected IsZero for %q", input)
+	}
+	_, err := fifa.ParseMatchMinute("HT")
+	assert.NotNil(t, err)
+}
+
+func TestMatchMinuteRoundTrip(t *testing.T) {
+	t.Parallel()
+	cases := map[string]string{
+		"":          "",
+		"0'":        "0'",
+		"0":         "0'",
+		"67'":       "67'",
+		"45'+2'":    "45'+2'",
+		"90'+5'":    "90'+5'",
+		"120'+3'":   "120'+3'",
+		"67":        "67'",
+		"90+5":      "90'+5'",
+		"90+5'":     "90'+5'",
+		"105'+1":    "105'+1'",
+		" 90' + 5'": "90'+5'",
+	}
+	for input, canonical := range cases {
+		m, err := fifa.ParseMatchMinute(input)
+		if ok := assert.Nil(t, err, "expected no error parsing %q, got: %s", input, err); !ok {
+			continue
+		}
+		assert.Equal(t, canonical, m.String(), "unexpected canonical form of %q", input)
+		again, err := fifa.ParseMatchMinute(m.String())
+		assert.Nil(t, err)
+		assert.Equal(t, m, again, "%q should parse back to the same minute", m.String())
+	}
+	var booking fifa.BookingResponse
+	err := json.Unmarshal([]byte(`{"Minute":"90'+5'","Period":5}`), &booking)
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, 95*60-60, booking.Minute.Seconds())
+	out, err := json.Marshal(booking.Minute)
+	assert.Nil(t, err)
+	assert.Equal(t, `"90'+5'"`, string(out))
+
+	var kickoff fifa.EventResponse
+	err = json.Unmarshal([]byte(`{"MatchMinute":"0'","Period":3}`), &kickoff)
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	assert.False(t, kickoff.MatchMinute.IsZero(), "0' is a minute, not an empty one")
+	assert.Equal(t, fifa.FIRST, kickoff.MatchMinute.Period)
+	out, err = json.Marshal(kickoff.MatchMinute)
+	assert.Nil(t, err)
+	assert.Equal(t, `"0'"`, string(out))
+
+	var event fifa.EventResponse
+	err = json.Unmarshal([]byte(`{"MatchMinute":"weird","Period":11}`), &event)
+	assert.Nil(t, err, "unknown minute formats should not fail decoding")
+	assert.Equal(t, "weird", event.MatchMinute.String())
+}
+
+func TestMatchMinuteOrdering(t *testing.T) {
+	t.Parallel()
+	var minutes []fifa.MatchMinute
+	for _, input := range []string{"90'+5'", "46'", "45'+2'", "45'", "120'", "3'"} {
+		m, _ := fifa.ParseMatchMinute(input)
+		minutes = append(minutes, m)
+	}
+	shootout, _ := fifa.ParseMatchMinute("120'")
+	minutes = append(minutes, shootout.WithPeriod(fifa.SHOOTOUT))
+	sort.Slice(minutes, func(i, j int) bool { return minutes[i].Before(minutes[j]) })
+	var got []string
+	for _, m := range minutes {
+		got = append(got, m.String())
+	}
+	assert.Equal(t, []string{"3'", "45'", "45'+2'", "46'", "90'+5'", "120'", "120'"}, got)
+	assert.Equal(t, fifa.SHOOTOUT, minutes[6].Period)
+}
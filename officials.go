@@ -0,0 +1,23 @@
+package go_fifa
+
+import (
+	"errors"
+	"fmt"
+)
+
+type GetOfficialOptions struct {
+	OfficialId string
+}
+
+func (c *Client) GetOfficial(opts *GetOfficialOptions) (*OfficialResponse, error) {
+	if opts.OfficialId == "" {
+		return nil, errors.New("officialId is required but was not provided")
+	}
+	var official OfficialResponse
+	url := fmt.Sprintf("/officials/%s", opts.OfficialId)
+	_, err := c.get(url, &official, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &official, nil
+}
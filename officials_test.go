@@ -0,0 +1,21 @@
+package go_fifa_test
+
+import (
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOfficial(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: &stubHTTPClient{responses: map[string]stubResponse{
+		"/officials/377181": {Body: `{"OfficialsId":"377181","IdCountry":"ARG","Name":[{"Locale":"en-GB","Description":"Nestor PITANA"}],"OfficialType":1}`},
+	}}}
+	resp, err := client.GetOfficial(&fifa.GetOfficialOptions{OfficialId: "377181"})
+	if ok := assert.Nil(t, err, "expected no error with GetOfficial, got: %s", err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "Nestor PITANA", resp.Name[0].Description)
+	assert.Equal(t, "ARG", resp.CountryId)
+}
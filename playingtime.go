@@ -0,0 +1,260 @@
+package go_fifa
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	regulationHalfMinutes = 45
+	extraHalfMinutes      = 15
+)
+
+// PlayingInterval is a stretch of time on the pitch, measured in minutes of
+// playing time elapsed since kick-off, including stoppage time.
+type PlayingInterval struct {
+	Start int
+	End   int
+}
+
+func (i PlayingInterval) Minutes() int {
+	return i.End - i.Start
+}
+
+type PlayerMinutes struct {
+	PlayerId  string
+	TeamId    string
+	Started   bool
+	Intervals []PlayingInterval
+	Minutes   int
+}
+
+type PlayingTime struct {
+	// Length is the total playing time of the match in minutes.
+	Length   int
+	Players  []PlayerMinutes
+	Warnings []string
+}
+
+func (p *PlayingTime) Player(playerId string) *PlayerMinutes {
+	for i := range p.Players {
+		if p.Players[i].PlayerId == playerId {
+			return &p.Players[i]
+		}
+	}
+	return nil
+}
+
+type periodLayout struct {
+	order  []PeriodEnum
+	length map[PeriodEnum]int
+	offset map[PeriodEnum]int
+	start  map[PeriodEnum]int
+}
+
+func (l periodLayout) total() int {
+	var total int
+	for _, p := range l.order {
+		total += l.length[p]
+	}
+	return total
+}
+
+// elapsed converts a match minute to minutes of playing time since kick-off.
+func (l periodLayout) elapsed(m MatchMinute) int {
+	if _, ok := l.length[m.Period]; !ok {
+		return l.total()
+	}
+	within := m.Minute - l.start[m.Period] + m.Added
+	if within < 0 {
+		within = 0
+	}
+	if within > l.length[m.Period] {
+		within = l.length[m.Period]
+	}
+	return l.offset[m.Period] + within
+}
+
+// ComputePlayingTime works out each player's intervals on the pitch and total
+// minutes from the starting line-ups, substitutions and red cards of a match.
+// FirstHalfTime and SecondHalfTime give the length of each half, and
+// FirstHalfExtraTime and SecondHalfExtraTime the stoppage time of each half
+// of extra time. For live matches playing time is counted up to MatchTime.
+// Contradictions in the data are reported as warnings rather than errors.
+func ComputePlayingTime(m *MatchResponse) *PlayingTime {
+	pt := &PlayingTime{}
+	layout := pt.layout(m)
+	end := layout.total()
+	if m.Status == LIVE {
+		if now, err := ParseMatchMinute(m.MatchTime); err == nil && !now.IsZero() {
+			end = layout.elapsed(now.WithPeriod(m.Period))
+		}
+	}
+	pt.Length = end
+	for _, team := range []*TeamResponse{&m.HomeTeam, &m.AwayTeam} {
+		pt.addTeam(team, layout, end)
+	}
+	return pt
+}
+
+func (pt *PlayingTime) warn(format string, args ...interface{}) {
+	pt.Warnings = append(pt.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (pt *PlayingTime) halfLength(value string, name string) int {
+	length := regulationHalfMinutes
+	if value == "" {
+		return length
+	}
+	m, err := ParseMatchMinute(value)
+	if err != nil {
+		pt.warn("could not parse %s %q, assuming %d minutes", name, value, length)
+		return length
+	}
+	if total := m.Minute + m.Added; total >= regulationHalfMinutes {
+		length = total
+	}
+	return length
+}
+
+func (pt *PlayingTime) layout(m *MatchResponse) periodLayout {
+	l := periodLayout{
+		order:  []PeriodEnum{FIRST, SECOND},
+		length: map[PeriodEnum]int{},
+		offset: map[PeriodEnum]int{},
+		start:  map[PeriodEnum]int{FIRST: 0, SECOND: 45, FIRST_EXTRA: 90, SECOND_EXTRA: 105},
+	}
+	l.length[FIRST] = pt.halfLength(m.FirstHalfTime, "FirstHalfTime")
+	l.length[SECOND] = pt.halfLength(m.SecondHalfTime, "SecondHalfTime")
+	if hadExtraTime(m) {
+		l.order = append(l.order, FIRST_EXTRA, SECOND_EXTRA)
+		l.length[FIRST_EXTRA] = extraHalfMinutes + m.FirstHalfExtraTime
+		l.length[SECOND_EXTRA] = extraHalfMinutes + m.SecondHalfExtraTime
+	}
+	var offset int
+	for _, p := range l.order {
+		l.offset[p] = offset
+		offset += l.length[p]
+	}
+	return l
+}
+
+// hadExtraTime reports whether a match reached extra time, going by its
+// period and the periods of its goals, substitutions and bookings.
+// FirstHalfExtraTime and SecondHalfExtraTime are not used since the API may
+// fill them in for matches decided in normal time.
+func hadExtraTime(m *MatchResponse) bool {
+	if m.Period >= FIRST_EXTRA {
+		return true
+	}
+	for _, team := range []*TeamResponse{&m.HomeTeam, &m.AwayTeam} {
+		for _, g := range team.Goals {
+			if inExtraTime(g.Period) || inExtraTime(g.Minute.Period) {
+				return true
+			}
+		}
+		for _, s := range team.Substitutions {
+			if inExtraTime(s.Minute.Period) {
+				return true
+			}
+		}
+		for _, b := range team.Bookings {
+			if inExtraTime(b.Minute.Period) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func inExtraTime(p PeriodEnum) bool {
+	return p >= FIRST_EXTRA && p != SHOOTOUT
+}
+
+type playingEvent struct {
+	at     int
+	off    string
+	on     string
+	reason string
+}
+
+func (pt *PlayingTime) addTeam(team *TeamResponse, layout periodLayout, end int) {
+	players := map[string]*PlayerMinutes{}
+	var order []string
+	player := func(id string) *PlayerMinutes {
+		p, ok := players[id]
+		if !ok {
+			p = &PlayerMinutes{PlayerId: id, TeamId: team.Id}
+			players[id] = p
+			order = append(order, id)
+		}
+		return p
+	}
+	onPitch := map[string]int{}
+	used := map[string]bool{}
+	for _, p := range team.Players {
+		if p.Status != STARTING {
+			continue
+		}
+		player(p.Id).Started = true
+		onPitch[p.Id] = 0
+		used[p.Id] = true
+	}
+	if len(team.Players) > 0 && len(onPitch) != 11 {
+		pt.warn("team %s has %d starting players", team.Id, len(onPitch))
+	}
+
+	var events []playingEvent
+	for _, s := range team.Substitutions {
+		if s.Minute.Period == SHOOTOUT {
+			continue
+		}
+		events = append(events, playingEvent{at: layout.elapsed(s.Minute), off: s.PlayerOffId, on: s.PlayerOnId, reason: "substitution"})
+	}
+	for _, b := range team.Bookings {
+		if (b.Card != RED && b.Card != YELLOW_RED) || b.PlayerId == "" || b.Minute.Period == SHOOTOUT {
+			continue
+		}
+		events = append(events, playingEvent{at: layout.elapsed(b.Minute), off: b.PlayerId, reason: "red card"})
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].at < events[j].at
+	})
+
+	for _, ev := range events {
+		at := ev.at
+		if at > end {
+			continue
+		}
+		if ev.off != "" {
+			start, ok := onPitch[ev.off]
+			if !ok {
+				pt.warn("player %s of team %s left the pitch (%s) at minute %d but was not on it", ev.off, team.Id, ev.reason, at)
+			} else {
+				p := player(ev.off)
+				p.Intervals = append(p.Intervals, PlayingInterval{Start: start, End: at})
+				delete(onPitch, ev.off)
+			}
+		}
+		if ev.on != "" {
+			if used[ev.on] {
+				pt.warn("player %s of team %s came on at minute %d but had already played", ev.on, team.Id, at)
+				continue
+			}
+			player(ev.on)
+			onPitch[ev.on] = at
+			used[ev.on] = true
+		}
+	}
+	for id, start := range onPitch {
+		p := player(id)
+		p.Intervals = append(p.Intervals, PlayingInterval{Start: start, End: end})
+	}
+	for _, id := range order {
+		p := players[id]
+		for _, i := range p.Intervals {
+			p.Minutes += i.Minutes()
+		}
+		pt.Players = append(pt.Players, *p)
+	}
+}
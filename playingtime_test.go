@@ -0,0 +1,114 @@
+package go_fifa_test
+
+import (
+	"fmt"
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func lineup(prefix string) []fifa.MatchPlayerResponse {
+	var players []fifa.MatchPlayerResponse
+	for i := 1; i <= 11; i++ {
+		players = append(players, fifa.MatchPlayerResponse{Id: fmt.Sprintf("%s%d", prefix, i), Status: fifa.STARTING})
+	}
+	return append(players, fifa.MatchPlayerResponse{Id: prefix + "12", Status: fifa.SUBSTITUTE})
+}
+
+func TestComputePlayingTime(t *testing.T) {
+	t.Parallel()
+	m := &fifa.MatchResponse{
+		Status:         fifa.PLAYED,
+		FirstHalfTime:  "45'+3'",
+		SecondHalfTime: "45'+5'",
+		HomeTeam: fifa.TeamResponse{
+			Id:      "H",
+			Players: lineup("h"),
+			Substitutions: []fifa.SubstitutionResponse{
+				{PlayerOffId: "h9", PlayerOnId: "h12", Minute: minute(t, "60'").WithPeriod(fifa.SECOND)},
+			},
+		},
+		AwayTeam: fifa.TeamResponse{
+			Id:      "A",
+			Players: lineup("a"),
+			Bookings: []fifa.BookingResponse{
+				{Card: fifa.YELLOW, PlayerId: "a4", Minute: minute(t, "30'").WithPeriod(fifa.FIRST)},
+				{Card: fifa.YELLOW_RED, PlayerId: "a4", Minute: minute(t, "45'+2'").WithPeriod(fifa.FIRST)},
+			},
+		},
+	}
+	pt := fifa.ComputePlayingTime(m)
+	assert.Empty(t, pt.Warnings)
+	assert.Equal(t, 98, pt.Length)
+
+	assert.Equal(t, 98, pt.Player("h1").Minutes)
+	assert.True(t, pt.Player("h1").Started)
+	assert.Equal(t, 63, pt.Player("h9").Minutes, "60' in the second half is 63 minutes after three minutes of stoppage")
+	sub := pt.Player("h12")
+	if ok := assert.NotNil(t, sub); ok {
+		assert.False(t, sub.Started)
+		assert.Equal(t, []fifa.PlayingInterval{{Start: 63, End: 98}}, sub.Intervals)
+		assert.Equal(t, 35, sub.Minutes)
+	}
+	assert.Equal(t, 47, pt.Player("a4").Minutes)
+	assert.Nil(t, pt.Player("a12"), "unused substitutes should not be listed")
+}
+
+func TestComputePlayingTimeExtraTimeAndWarnings(t *testing.T) {
+	t.Parallel()
+	m := &fifa.MatchResponse{
+		Status:              fifa.PLAYED,
+		Period:              fifa.SHOOTOUT,
+		FirstHalfTime:       "45'",
+		SecondHalfTime:      "45'",
+		FirstHalfExtraTime:  1,
+		SecondHalfExtraTime: 2,
+		HomeTeam: fifa.TeamResponse{
+			Id:      "H",
+			Players: lineup("h")[:10],
+			Substitutions: []fifa.SubstitutionResponse{
+				{PlayerOffId: "h99", PlayerOnId: "h12", Minute: minute(t, "100'").WithPeriod(fifa.FIRST_EXTRA)},
+				{PlayerOffId: "h12", PlayerOnId: "h1", Minute: minute(t, "110'").WithPeriod(fifa.SECOND_EXTRA)},
+			},
+		},
+	}
+	pt := fifa.ComputePlayingTime(m)
+	assert.Equal(t, 123, pt.Length)
+	assert.Len(t, pt.Warnings, 3, "expected warnings for line-up size, unknown player off and re-entry: %v", pt.Warnings)
+	assert.Equal(t, 11, pt.Player("h12").Minutes, "includes a minute of stoppage in the first half of extra time")
+}
+
+func TestComputePlayingTimeRegularTimeWithStoppageFields(t *testing.T) {
+	t.Parallel()
+	m := &fifa.MatchResponse{
+		Status:              fifa.PLAYED,
+		Period:              fifa.END_OF_SECOND,
+		FirstHalfTime:       "45'+2'",
+		SecondHalfTime:      "45'+4'",
+		FirstHalfExtraTime:  2,
+		SecondHalfExtraTime: 4,
+		HomeTeam:            fifa.TeamResponse{Id: "H", Players: lineup("h")},
+	}
+	pt := fifa.ComputePlayingTime(m)
+	assert.Equal(t, 96, pt.Length, "stoppage fields alone should not add extra time")
+	assert.Equal(t, 96, pt.Player("h1").Minutes)
+
+	m.Period = 0
+	m.HomeTeam.Goals = []fifa.GoalResponse{{PlayerId: "h9", Period: fifa.SECOND_EXTRA, Minute: minute(t, "118'").WithPeriod(fifa.SECOND_EXTRA)}}
+	assert.Equal(t, 132, fifa.ComputePlayingTime(m).Length, "a goal in extra time shows it was played")
+}
+
+func TestComputePlayingTimeLive(t *testing.T) {
+	t.Parallel()
+	m := &fifa.MatchResponse{
+		Status:        fifa.LIVE,
+		Period:        fifa.SECOND,
+		MatchTime:     "70'",
+		FirstHalfTime: "45'+2'",
+		HomeTeam:      fifa.TeamResponse{Id: "H", Players: lineup("h")},
+	}
+	pt := fifa.ComputePlayingTime(m)
+	assert.Equal(t, 72, pt.Length)
+	assert.Equal(t, 72, pt.Player("h1").Minutes)
+}
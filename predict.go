@@ -0,0 +1,306 @@
+package go_fifa
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	defaultPredictionHalfLife = 365 * 24 * time.Hour
+	defaultPoissonIterations  = 50
+	defaultMaxGoals           = 10
+	defaultPoissonPrior       = 1
+)
+
+type PoissonOptions struct {
+	// HalfLife is the age at which a result counts half as much as one
+	// played at Now. Defaults to a year.
+	HalfLife time.Duration
+	// Now is the date results are weighted against, defaulting to the date
+	// of the latest result.
+	Now time.Time
+	// Iterations of the fitting algorithm, defaulting to 50.
+	Iterations int
+	// Prior is the weight, in matches, pulling every team towards average
+	// strength so teams with few results do not get extreme ratings.
+	Prior float64
+	// MaxGoals is the highest score per team in predicted score matrices,
+	// defaulting to 10.
+	MaxGoals int
+}
+
+// PoissonModel predicts goals as independent Poisson variables whose means
+// are the product of the base rate, the attack strength of the scoring team,
+// the defence weakness of the conceding team and, for a team playing in its
+// own country, the home advantage.
+type PoissonModel struct {
+	Attack        map[string]float64
+	Defence       map[string]float64
+	HomeAdvantage float64
+	// BaseRate is the average number of goals a team scores per match.
+	BaseRate float64
+	MaxGoals int
+}
+
+type Prediction struct {
+	HomeTeamId        string
+	AwayTeamId        string
+	HomeExpectedGoals float64
+	AwayExpectedGoals float64
+	HomeWin           float64
+	Draw              float64
+	AwayWin           float64
+	// Scores[h][a] is the probability of the match ending h-a.
+	Scores [][]float64
+}
+
+// playsAtHome reports whether the team plays in its own country.
+func playsAtHome(m *MatchResponse, team *TeamResponse) bool {
+	return m.Stadium.CountryId != "" && m.Stadium.CountryId == team.CountryId
+}
+
+type weightedResult struct {
+	home, away       string
+	homeGoals        float64
+	awayGoals        float64
+	homeAdv, awayAdv bool
+	weight           float64
+}
+
+// FitPoisson fits attack and defence strengths to finished matches, weighting
+// results by their age.
+func FitPoisson(matches []MatchResponse, opts *PoissonOptions) *PoissonModel {
+	o := PoissonOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.HalfLife <= 0 {
+		o.HalfLife = defaultPredictionHalfLife
+	}
+	if o.Iterations <= 0 {
+		o.Iterations = defaultPoissonIterations
+	}
+	if o.Prior <= 0 {
+		o.Prior = defaultPoissonPrior
+	}
+	if o.MaxGoals <= 0 {
+		o.MaxGoals = defaultMaxGoals
+	}
+	if o.Now.IsZero() {
+		for _, m := range matches {
+			if m.Status == PLAYED && m.Date.After(o.Now) {
+				o.Now = m.Date
+			}
+		}
+	}
+
+	model := &PoissonModel{
+		Attack:        map[string]float64{},
+		Defence:       map[string]float64{},
+		HomeAdvantage: 1,
+		BaseRate:      1,
+		MaxGoals:      o.MaxGoals,
+	}
+	var results []weightedResult
+	var goals, weights float64
+	for i := range matches {
+		m := &matches[i]
+		if m.Status != PLAYED || m.HomeTeam.Id == "" || m.AwayTeam.Id == "" {
+			continue
+		}
+		age := o.Now.Sub(m.Date)
+		if age < 0 {
+			age = 0
+		}
+		w := math.Pow(0.5, float64(age)/float64(o.HalfLife))
+		results = append(results, weightedResult{
+			home:      m.HomeTeam.Id,
+			away:      m.AwayTeam.Id,
+			homeGoals: float64(m.HomeTeam.Score),
+			awayGoals: float64(m.AwayTeam.Score),
+			homeAdv:   playsAtHome(m, &m.HomeTeam),
+			awayAdv:   playsAtHome(m, &m.AwayTeam),
+			weight:    w,
+		})
+		goals += w * float64(m.HomeTeam.Score+m.AwayTeam.Score)
+		weights += 2 * w
+		model.Attack[m.HomeTeam.Id] = 1
+		model.Attack[m.AwayTeam.Id] = 1
+		model.Defence[m.HomeTeam.Id] = 1
+		model.Defence[m.AwayTeam.Id] = 1
+	}
+	if weights == 0 {
+		return model
+	}
+	model.BaseRate = goals / weights
+
+	for it := 0; it < o.Iterations; it++ {
+		scored := map[string]float64{}
+		expected := map[string]float64{}
+		for _, r := range results {
+			scored[r.home] += r.weight * r.homeGoals
+			scored[r.away] += r.weight * r.awayGoals
+			expected[r.home] += r.weight * model.BaseRate * model.Defence[r.away] * model.advantage(r.homeAdv)
+			expected[r.away] += r.weight * model.BaseRate * model.Defence[r.home] * model.advantage(r.awayAdv)
+		}
+		for id := range model.Attack {
+			model.Attack[id] = (scored[id] + o.Prior*model.BaseRate) / (expected[id] + o.Prior*model.BaseRate)
+		}
+		normalizeStrengths(model.Attack)
+
+		conceded := map[string]float64{}
+		expected = map[string]float64{}
+		for _, r := range results {
+			conceded[r.away] += r.weight * r.homeGoals
+			conceded[r.home] += r.weight * r.awayGoals
+			expected[r.away] += r.weight * model.BaseRate * model.Attack[r.home] * model.advantage(r.homeAdv)
+			expected[r.home] += r.weight * model.BaseRate * model.Attack[r.away] * model.advantage(r.awayAdv)
+		}
+		for id := range model.Defence {
+			model.Defence[id] = (conceded[id] + o.Prior*model.BaseRate) / (expected[id] + o.Prior*model.BaseRate)
+		}
+
+		var homeGoals, homeExpected float64
+		for _, r := range results {
+			if r.homeAdv {
+				homeGoals += r.weight * r.homeGoals
+				homeExpected += r.weight * model.BaseRate * model.Attack[r.home] * model.Defence[r.away]
+			}
+			if r.awayAdv {
+				homeGoals += r.weight * r.awayGoals
+				homeExpected += r.weight * model.BaseRate * model.Attack[r.away] * model.Defence[r.home]
+			}
+		}
+		if homeExpected > 0 {
+			model.HomeAdvantage = (homeGoals + o.Prior*model.BaseRate) / (homeExpected + o.Prior*model.BaseRate)
+		}
+	}
+	return model
+}
+
+// normalizeStrengths scales strengths to a geometric mean of one.
+func normalizeStrengths(s map[string]float64) {
+	if len(s) == 0 {
+		return
+	}
+	var sum float64
+	for _, v := range s {
+		sum += math.Log(v)
+	}
+	scale := math.Exp(sum / float64(len(s)))
+	for id, v := range s {
+		s[id] = v / scale
+	}
+}
+
+func (p *PoissonModel) advantage(home bool) float64 {
+	if home {
+		return p.HomeAdvantage
+	}
+	return 1
+}
+
+func (p *PoissonModel) strength(s map[string]float64, teamId string) float64 {
+	if v, ok := s[teamId]; ok {
+		return v
+	}
+	return 1
+}
+
+// ExpectedGoals returns the expected goals of both teams. homeAdv and awayAdv
+// tell whether each team plays in its own country. Teams without results
+// are treated as average.
+func (p *PoissonModel) ExpectedGoals(homeTeamId string, awayTeamId string, homeAdv bool, awayAdv bool) (float64, float64) {
+	home := p.BaseRate * p.strength(p.Attack, homeTeamId) * p.strength(p.Defence, awayTeamId) * p.advantage(homeAdv)
+	away := p.BaseRate * p.strength(p.Attack, awayTeamId) * p.strength(p.Defence, homeTeamId) * p.advantage(awayAdv)
+	return home, away
+}
+
+// Predict predicts a match at a neutral venue, or with the home team playing
+// in its own country when neutral is false.
+func (p *PoissonModel) Predict(homeTeamId string, awayTeamId string, neutral bool) Prediction {
+	home, away := p.ExpectedGoals(homeTeamId, awayTeamId, !neutral, false)
+	return NewPrediction(homeTeamId, awayTeamId, home, away, p.MaxGoals)
+}
+
+// PredictMatch predicts a fixture, giving home advantage to a team playing in
+// the country of the stadium.
+func (p *PoissonModel) PredictMatch(m *MatchResponse) Prediction {
+	home, away := p.ExpectedGoals(m.HomeTeam.Id, m.AwayTeam.Id, playsAtHome(m, &m.HomeTeam), playsAtHome(m, &m.AwayTeam))
+	return NewPrediction(m.HomeTeam.Id, m.AwayTeam.Id, home, away, p.MaxGoals)
+}
+
+// NewPrediction builds the score matrix of two independent Poisson goal
+// counts, cut off at maxGoals per team and normalized.
+func NewPrediction(homeTeamId string, awayTeamId string, homeGoals float64, awayGoals float64, maxGoals int) Prediction {
+	if maxGoals <= 0 {
+		maxGoals = defaultMaxGoals
+	}
+	pred := Prediction{
+		HomeTeamId:        homeTeamId,
+		AwayTeamId:        awayTeamId,
+		HomeExpectedGoals: homeGoals,
+		AwayExpectedGoals: awayGoals,
+	}
+	homeP := poissonProbabilities(homeGoals, maxGoals)
+	awayP := poissonProbabilities(awayGoals, maxGoals)
+	var total float64
+	pred.Scores = make([][]float64, maxGoals+1)
+	for h := range pred.Scores {
+		pred.Scores[h] = make([]float64, maxGoals+1)
+		for a := range pred.Scores[h] {
+			pred.Scores[h][a] = homeP[h] * awayP[a]
+			total += pred.Scores[h][a]
+		}
+	}
+	for h := range pred.Scores {
+		for a := range pred.Scores[h] {
+			pred.Scores[h][a] /= total
+			switch {
+			case h > a:
+				pred.HomeWin += pred.Scores[h][a]
+			case h < a:
+				pred.AwayWin += pred.Scores[h][a]
+			default:
+				pred.Draw += pred.Scores[h][a]
+			}
+		}
+	}
+	return pred
+}
+
+func poissonProbabilities(mean float64, max int) []float64 {
+	p := make([]float64, max+1)
+	p[0] = math.Exp(-mean)
+	for k := 1; k <= max; k++ {
+		p[k] = p[k-1] * mean / float64(k)
+	}
+	return p
+}
+
+// MostLikelyScore returns the single most probable score.
+func (p Prediction) MostLikelyScore() (int, int) {
+	var home, away int
+	best := -1.0
+	for h := range p.Scores {
+		for a, v := range p.Scores[h] {
+			if v > best {
+				best, home, away = v, h, a
+			}
+		}
+	}
+	return home, away
+}
+
+// GetPoissonModel fits a Poisson model to the calendars of the given seasons.
+func (c *Client) GetPoissonModel(seasons []GetSeasonMatchesOptions, opts *PoissonOptions) (*PoissonModel, error) {
+	var matches []MatchResponse
+	for i := range seasons {
+		m, err := c.GetSeasonMatches(&seasons[i])
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, m...)
+	}
+	return FitPoisson(matches, opts), nil
+}
@@ -0,0 +1,95 @@
+package go_fifa_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPrediction(t *testing.T) {
+	t.Parallel()
+	p := fifa.NewPrediction("A", "B", 1.3, 1.3, 10)
+	var total float64
+	for _, row := range p.Scores {
+		for _, v := range row {
+			total += v
+		}
+	}
+	assert.InDelta(t, 1, total, 1e-9)
+	assert.InDelta(t, 1, p.HomeWin+p.Draw+p.AwayWin, 1e-9)
+	assert.InDelta(t, p.HomeWin, p.AwayWin, 1e-9)
+	assert.InDelta(t, math.Exp(-2.6), p.Scores[0][0], 1e-4)
+	h, a := p.MostLikelyScore()
+	assert.Equal(t, 1, h)
+	assert.Equal(t, 1, a)
+}
+
+func TestFitPoisson(t *testing.T) {
+	t.Parallel()
+	day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	var matches []fifa.MatchResponse
+	for i := 0; i < 4; i++ {
+		d := day.AddDate(0, 0, i*7)
+		matches = append(matches,
+			testMatch("", "S", 3, "W", 0, playedOn(d)),
+			testMatch("", "M", 2, "W", 1, playedOn(d)),
+			testMatch("", "S", 2, "M", 0, playedOn(d)),
+		)
+	}
+	model := fifa.FitPoisson(matches, nil)
+	assert.Greater(t, model.Attack["S"], model.Attack["M"])
+	assert.Greater(t, model.Attack["M"], model.Attack["W"])
+	assert.Less(t, model.Defence["S"], model.Defence["W"], "S concedes less than W")
+
+	p := model.Predict("S", "W", true)
+	assert.Greater(t, p.HomeWin, 0.6)
+	assert.Greater(t, p.HomeExpectedGoals, p.AwayExpectedGoals)
+	reverse := model.Predict("W", "S", true)
+	assert.InDelta(t, p.HomeWin, reverse.AwayWin, 1e-9, "a neutral venue should not favour either side")
+
+	unknown := model.Predict("X", "Y", true)
+	assert.InDelta(t, unknown.HomeWin, unknown.AwayWin, 1e-9)
+}
+
+func TestFitPoissonTimeDecay(t *testing.T) {
+	t.Parallel()
+	old := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	recent := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	matches := []fifa.MatchResponse{
+		testMatch("", "A", 0, "B", 4, playedOn(old)),
+		testMatch("", "A", 0, "B", 4, playedOn(old)),
+		testMatch("", "A", 2, "B", 0, playedOn(recent)),
+	}
+	decayed := fifa.FitPoisson(matches, &fifa.PoissonOptions{HalfLife: 90 * 24 * time.Hour})
+	flat := fifa.FitPoisson(matches, &fifa.PoissonOptions{HalfLife: 100 * 365 * 24 * time.Hour})
+	assert.Greater(t, decayed.Predict("A", "B", true).HomeWin, 0.5)
+	assert.Less(t, flat.Predict("A", "B", true).HomeWin, 0.5)
+}
+
+func TestFitPoissonHomeAdvantage(t *testing.T) {
+	t.Parallel()
+	day := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	var matches []fifa.MatchResponse
+	for i := 0; i < 6; i++ {
+		home := testMatch("", "H", 2, "V", 0, playedOn(day.AddDate(0, 0, i)))
+		home.Stadium.CountryId = "QAT"
+		home.HomeTeam.CountryId = "QAT"
+		away := testMatch("", "V", 2, "H", 0, playedOn(day.AddDate(0, 0, i)))
+		away.Stadium.CountryId = "ECU"
+		away.HomeTeam.CountryId = "ECU"
+		matches = append(matches, home, away)
+	}
+	model := fifa.FitPoisson(matches, nil)
+	assert.Greater(t, model.HomeAdvantage, 1.5)
+
+	fixture := testMatch("", "H", 0, "V", 0, playedOn(day))
+	fixture.Status = fifa.TO_BE_PLAYED
+	fixture.Stadium.CountryId = "QAT"
+	fixture.HomeTeam.CountryId = "QAT"
+	atHome := model.PredictMatch(&fixture)
+	neutral := model.Predict("H", "V", true)
+	assert.Greater(t, atHome.HomeWin, neutral.HomeWin)
+}
@@ -0,0 +1,451 @@
+package go_fifa
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+const defaultMaxScenarios = 100000
+
+var ErrTooManyScenarios = errors.New("too many scenarios to enumerate")
+
+type QualificationStatus int
+
+const (
+	// QualificationOpen means the outcome depends on results not yet known.
+	QualificationOpen QualificationStatus = iota
+	QualificationClinched
+	QualificationEliminated
+)
+
+func (s QualificationStatus) String() string {
+	switch s {
+	case QualificationClinched:
+		return "clinched"
+	case QualificationEliminated:
+		return "eliminated"
+	}
+	return "open"
+}
+
+type ScenarioOptions struct {
+	// Rules defaults to WorldCupStandingsRules.
+	Rules StandingsRules
+	// Qualifying is the number of teams of each group that go through,
+	// defaulting to 2.
+	Qualifying int
+	// BestThirds is the number of teams finishing just below the qualifying
+	// places that also go through, compared across groups.
+	BestThirds int
+	// Margins are the winning margins tried for each remaining match. By
+	// default only wins by one goal and goalless draws are tried. Since
+	// other margins could change them, positions decided on goals or by
+	// drawing of lots are reported as open whatever the margins.
+	Margins []int
+	// MaxScenarios caps the number of outcomes enumerated per group,
+	// defaulting to 100000.
+	MaxScenarios int
+}
+
+// TeamScenarios describes what a team needs from its remaining matches.
+type TeamScenarios struct {
+	TeamId  string
+	GroupId string
+	Status  QualificationStatus
+	// Scenarios is the number of outcomes of the group enumerated, and
+	// Qualified how many of them send the team through regardless of the
+	// other groups.
+	Scenarios int
+	Qualified int
+	// Positions counts the outcomes by the team's final position.
+	Positions map[int]int
+	// Needs maps the results of the team's own remaining matches, in date
+	// order and from its point of view (e.g. "W", "DL"), to the status they
+	// lead to.
+	Needs map[string]QualificationStatus
+}
+
+type groupScenario struct {
+	positions map[string]int
+	outcomes  map[string]string
+	// undecided holds the first and last position teams may take when their
+	// side of a qualification line depends on goals or on drawing of lots.
+	undecided map[string][2]int
+}
+
+type groupScenarios struct {
+	id string
+	// playing holds the teams with matches left, whose goals can still
+	// change.
+	playing   map[string]bool
+	scenarios []groupScenario
+	// rows are the final rows of every team per scenario, kept only for
+	// the teams that may finish in the place compared across groups.
+	rows []map[string]StandingsResult
+	// best and worst are the best and worst possible rows finishing in the
+	// place compared across groups.
+	best  *StandingsResult
+	worst *StandingsResult
+}
+
+// ComputeScenarios enumerates the outcomes of the remaining group matches,
+// including live ones, and reports for every team whether it has qualified,
+// been eliminated or still depends on results. Groups are enumerated
+// independently, so best placed teams across groups are compared against the
+// best and worst rows the other groups can produce.
+func ComputeScenarios(matches []MatchResponse, opts *ScenarioOptions) ([]TeamScenarios, error) {
+	o := ScenarioOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Rules.TieBreakers == nil {
+		o.Rules = WorldCupStandingsRules
+	}
+	if o.Qualifying <= 0 {
+		o.Qualifying = 2
+	}
+	if len(o.Margins) == 0 {
+		o.Margins = []int{1}
+	}
+	if o.MaxScenarios <= 0 {
+		o.MaxScenarios = defaultMaxScenarios
+	}
+
+	byGroup := map[string][]MatchResponse{}
+	for _, m := range matches {
+		if m.GroupId != "" {
+			byGroup[m.GroupId] = append(byGroup[m.GroupId], m)
+		}
+	}
+	groupIds := make([]string, 0, len(byGroup))
+	for id := range byGroup {
+		groupIds = append(groupIds, id)
+	}
+	sort.Strings(groupIds)
+
+	var groups []*groupScenarios
+	for _, id := range groupIds {
+		g, err := enumerateGroup(id, byGroup[id], &o)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+
+	var out []TeamScenarios
+	for _, g := range groups {
+		teams := map[string]*TeamScenarios{}
+		var order []string
+		for i, s := range g.scenarios {
+			for teamId, pos := range s.positions {
+				ts, ok := teams[teamId]
+				if !ok {
+					ts = &TeamScenarios{
+						TeamId:    teamId,
+						GroupId:   g.id,
+						Positions: map[int]int{},
+						Needs:     map[string]QualificationStatus{},
+					}
+					teams[teamId] = ts
+					order = append(order, teamId)
+				}
+				row := g.rows[i][teamId]
+				status := scenarioStatus(pos, row, g, groups, &o)
+				if span, ok := s.undecided[teamId]; ok {
+					for p := span[0]; p <= span[1]; p++ {
+						if scenarioStatus(p, row, g, groups, &o) != status {
+							status = QualificationOpen
+							break
+						}
+					}
+				}
+				ts.Scenarios++
+				ts.Positions[pos]++
+				if status == QualificationClinched {
+					ts.Qualified++
+				}
+				need := s.outcomes[teamId]
+				if prev, ok := ts.Needs[need]; ok && prev != status {
+					status = QualificationOpen
+				}
+				ts.Needs[need] = status
+			}
+		}
+		sort.Strings(order)
+		for _, id := range order {
+			ts := teams[id]
+			ts.Status = QualificationOpen
+			switch ts.Qualified {
+			case ts.Scenarios:
+				ts.Status = QualificationClinched
+			case 0:
+				eliminated := true
+				for _, s := range ts.Needs {
+					if s != QualificationEliminated {
+						eliminated = false
+					}
+				}
+				if eliminated {
+					ts.Status = QualificationEliminated
+				}
+			}
+			out = append(out, *ts)
+		}
+	}
+	return out, nil
+}
+
+func scenarioStatus(pos int, row StandingsResult, g *groupScenarios, groups []*groupScenarios, o *ScenarioOptions) QualificationStatus {
+	if pos <= o.Qualifying {
+		return QualificationClinched
+	}
+	if pos > o.Qualifying+1 || o.BestThirds <= 0 {
+		return QualificationEliminated
+	}
+	// A row only certainly ranks above another when it does so on a
+	// tie-breaker that other margins and lots cannot change.
+	canBeat, mustBeat := 0, 0
+	for _, other := range groups {
+		if other == g || other.best == nil {
+			continue
+		}
+		c, by := compareAcrossGroupsBy(other.best, &row, o.Rules)
+		if c >= 0 || !decisive(by, g.playing[row.Team.Id] || other.playing[other.best.Team.Id]) {
+			canBeat++
+		}
+		c, by = compareAcrossGroupsBy(other.worst, &row, o.Rules)
+		if c > 0 && decisive(by, g.playing[row.Team.Id] || other.playing[other.worst.Team.Id]) {
+			mustBeat++
+		}
+	}
+	switch {
+	case mustBeat >= o.BestThirds:
+		return QualificationEliminated
+	case canBeat < o.BestThirds:
+		return QualificationClinched
+	}
+	return QualificationOpen
+}
+
+func enumerateGroup(id string, matches []MatchResponse, o *ScenarioOptions) (*groupScenarios, error) {
+	matches = append([]MatchResponse(nil), matches...)
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Date.Before(matches[j].Date)
+	})
+	var remaining []int
+	for i, m := range matches {
+		if (m.Status == TO_BE_PLAYED || m.Status == LIVE) && m.HomeTeam.Id != "" && m.AwayTeam.Id != "" {
+			remaining = append(remaining, i)
+		}
+	}
+	margins := []int{0}
+	for _, m := range o.Margins {
+		if m > 0 {
+			margins = append(margins, m, -m)
+		}
+	}
+	total := 1
+	for range remaining {
+		total *= len(margins)
+		if total > o.MaxScenarios {
+			return nil, ErrTooManyScenarios
+		}
+	}
+
+	g := &groupScenarios{id: id, playing: map[string]bool{}}
+	for _, idx := range remaining {
+		g.playing[matches[idx].HomeTeam.Id] = true
+		g.playing[matches[idx].AwayTeam.Id] = true
+	}
+	choice := make([]int, len(remaining))
+	scenario := make([]MatchResponse, len(matches))
+	for n := 0; n < total; n++ {
+		copy(scenario, matches)
+		outcomes := map[string]string{}
+		for k, idx := range remaining {
+			m := &scenario[idx]
+			m.HomeTeam.Score, m.AwayTeam.Score = finalScore(m.HomeTeam.Score, m.AwayTeam.Score, margins[choice[k]])
+			m.Status = PLAYED
+			home, away := "D", "D"
+			if m.HomeTeam.Score > m.AwayTeam.Score {
+				home, away = "W", "L"
+			} else if m.HomeTeam.Score < m.AwayTeam.Score {
+				home, away = "L", "W"
+			}
+			outcomes[m.HomeTeam.Id] += home
+			outcomes[m.AwayTeam.Id] += away
+		}
+		rows := map[string]StandingsResult{}
+		positions := map[string]int{}
+		table, undecided := scenarioTable(scenario, g.playing, o)
+		for _, r := range table {
+			positions[r.Team.Id] = r.Position
+			span, ok := undecided[r.Team.Id]
+			if r.Position == o.Qualifying+1 || ok && span[0] <= o.Qualifying+1 && o.Qualifying+1 <= span[1] {
+				r.MatchResults = nil
+				rows[r.Team.Id] = r
+				if g.best == nil || compareAcrossGroups(&r, g.best, o.Rules) > 0 {
+					best := r
+					g.best = &best
+				}
+				if g.worst == nil || compareAcrossGroups(&r, g.worst, o.Rules) < 0 {
+					worst := r
+					g.worst = &worst
+				}
+			}
+		}
+		g.scenarios = append(g.scenarios, groupScenario{positions: positions, outcomes: outcomes, undecided: undecided})
+		g.rows = append(g.rows, rows)
+
+		for k := range choice {
+			choice[k]++
+			if choice[k] < len(margins) {
+				break
+			}
+			choice[k] = 0
+		}
+	}
+	return g, nil
+}
+
+// scenarioTable ranks a group once its remaining matches are decided. Teams
+// separated across the qualification line, or the line of the place compared
+// across groups, on goals or by drawing of lots could end on either side of
+// it, so the first and last position each of them may take is returned.
+func scenarioTable(matches []MatchResponse, playing map[string]bool, o *ScenarioOptions) ([]StandingsResult, map[string][2]int) {
+	var played []MatchResponse
+	for _, m := range matches {
+		if m.Status == PLAYED {
+			played = append(played, m)
+		}
+	}
+	splits := map[string]TieBreaker{}
+	table := buildStandings(matches, played, o.Rules, splits)
+	split := func(i int) bool {
+		by, ok := splits[table[i].Team.Id]
+		return ok && !decisive(by, playing[table[i].Team.Id] || playing[table[i-1].Team.Id])
+	}
+	lines := []int{o.Qualifying}
+	if o.BestThirds > 0 {
+		lines = append(lines, o.Qualifying+1)
+	}
+	undecided := map[string][2]int{}
+	for _, line := range lines {
+		if line >= len(table) || !split(line) {
+			continue
+		}
+		lo, hi := line-1, line
+		for lo > 0 && split(lo) {
+			lo--
+		}
+		for hi+1 < len(table) && split(hi+1) {
+			hi++
+		}
+		for i := lo; i <= hi; i++ {
+			span, ok := undecided[table[i].Team.Id]
+			if !ok || span[0] > lo+1 {
+				span[0] = lo + 1
+			}
+			if span[1] < hi+1 {
+				span[1] = hi + 1
+			}
+			undecided[table[i].Team.Id] = span
+		}
+	}
+	return table, undecided
+}
+
+// decisive reports whether a tie-breaker ranks two teams the same way with
+// any winning margins and drawing of lots. Goal tie-breakers are only
+// decisive when neither team has a match left to change its goals.
+func decisive(t TieBreaker, playing bool) bool {
+	switch t {
+	case TieBreakDrawingOfLots:
+		return false
+	case TieBreakGoalDifference, TieBreakGoalsScored, TieBreakHeadToHeadGoalDifference, TieBreakHeadToHeadGoalsScored:
+		return !playing
+	}
+	return true
+}
+
+// finalScore returns the smallest final score reachable from the current one
+// in which the home team wins by diff goals (losing when negative).
+func finalScore(home int, away int, diff int) (int, int) {
+	switch {
+	case home-away == diff:
+		return home, away
+	case home-away < diff:
+		return away + diff, away
+	}
+	return home, home - diff
+}
+
+// compareAcrossGroups compares rows of different groups with the tie-breakers
+// that do not depend on matches between the teams. It returns a positive
+// number when a ranks above b and zero when they cannot be separated.
+func compareAcrossGroups(a *StandingsResult, b *StandingsResult, rules StandingsRules) int {
+	c, _ := compareAcrossGroupsBy(a, b, rules)
+	return c
+}
+
+// compareAcrossGroupsBy is compareAcrossGroups that also returns the
+// tie-breaker that separated the rows, or TieBreakDrawingOfLots when none
+// did.
+func compareAcrossGroupsBy(a *StandingsResult, b *StandingsResult, rules StandingsRules) (int, TieBreaker) {
+	for _, t := range rules.TieBreakers {
+		if t.isHeadToHead() || t == TieBreakDrawingOfLots {
+			continue
+		}
+		ka, kb := standingsKey(t, a, nil), standingsKey(t, b, nil)
+		if ka != kb {
+			if ka > kb {
+				return 1, t
+			}
+			return -1, t
+		}
+	}
+	return 0, TieBreakDrawingOfLots
+}
+
+// RankAcrossGroups ranks rows from different groups, such as the third placed
+// teams, by points, goal difference, goals scored and the other tie-breakers
+// that do not depend on matches between the teams, drawing lots last.
+func RankAcrossGroups(rows []StandingsResult, rules StandingsRules) []StandingsResult {
+	out := append([]StandingsResult(nil), rows...)
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Team.Id < out[j].Team.Id
+	})
+	ptrs := make([]*StandingsResult, len(out))
+	for i := range out {
+		ptrs[i] = &out[i]
+	}
+	lots := drawLots(ptrs, rules.LotsSeed)
+	sort.SliceStable(out, func(i, j int) bool {
+		if c := compareAcrossGroups(&out[i], &out[j], rules); c != 0 {
+			return c > 0
+		}
+		return lots[out[i].Team.Id] > lots[out[j].Team.Id]
+	})
+	return out
+}
+
+// GetScenarios computes the qualification scenarios of a season's groups from
+// its calendar.
+func (c *Client) GetScenarios(season *GetSeasonMatchesOptions, opts *ScenarioOptions) ([]TeamScenarios, error) {
+	matches, err := c.GetSeasonMatches(season)
+	if err != nil {
+		return nil, err
+	}
+	return ComputeScenarios(matches, opts)
+}
+
+// Need returns the status a team ends with if its remaining matches end as
+// given, e.g. "W" or "DL". Results that are not enumerated are open.
+func (t TeamScenarios) Need(results string) QualificationStatus {
+	s, ok := t.Needs[strings.ToUpper(results)]
+	if !ok {
+		return QualificationOpen
+	}
+	return s
+}
@@ -0,0 +1,146 @@
+package go_fifa_test
+
+import (
+	"errors"
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func scenarioGroup() []fifa.MatchResponse {
+	return []fifa.MatchResponse{
+		testMatch("1", "A", 1, "B", 0, inGroupId("A")),
+		testMatch("2", "C", 0, "D", 0, inGroupId("A")),
+		testMatch("3", "A", 2, "C", 0, inGroupId("A")),
+		testMatch("4", "B", 1, "D", 0, inGroupId("A")),
+		testMatch("5", "A", 0, "D", 0, inGroupId("A"), toBePlayed),
+		testMatch("6", "B", 0, "C", 0, inGroupId("A"), toBePlayed),
+	}
+}
+
+func scenariosByTeam(scenarios []fifa.TeamScenarios) map[string]fifa.TeamScenarios {
+	out := map[string]fifa.TeamScenarios{}
+	for _, s := range scenarios {
+		out[s.TeamId] = s
+	}
+	return out
+}
+
+func TestComputeScenarios(t *testing.T) {
+	t.Parallel()
+	scenarios, err := fifa.ComputeScenarios(scenarioGroup(), nil)
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	teams := scenariosByTeam(scenarios)
+	if ok := assert.Len(t, teams, 4); !ok {
+		t.FailNow()
+	}
+
+	assert.Equal(t, fifa.QualificationClinched, teams["A"].Status)
+	assert.Equal(t, 9, teams["A"].Scenarios)
+	d := teams["D"]
+	assert.Equal(t, fifa.QualificationOpen, d.Status, "a win lifts D level with C, ahead on goal difference")
+	assert.Equal(t, fifa.QualificationOpen, d.Need("W"))
+	assert.Equal(t, fifa.QualificationEliminated, d.Need("D"))
+
+	b := teams["B"]
+	assert.Equal(t, fifa.QualificationOpen, b.Status)
+	assert.Equal(t, fifa.QualificationClinched, b.Need("W"))
+	assert.Equal(t, fifa.QualificationClinched, b.Need("D"))
+	assert.Equal(t, fifa.QualificationEliminated, b.Need("L"))
+	assert.Equal(t, 6, b.Qualified)
+
+	c := teams["C"]
+	assert.Equal(t, fifa.QualificationOpen, c.Need("w"))
+	assert.Equal(t, fifa.QualificationEliminated, c.Need("D"))
+	assert.Equal(t, 2, c.Positions[2])
+}
+
+func TestComputeScenariosBestThirds(t *testing.T) {
+	t.Parallel()
+	matches := scenarioGroup()
+	for _, m := range []fifa.MatchResponse{
+		testMatch("7", "P", 1, "Q", 0, inGroupId("A")),
+		testMatch("8", "P", 5, "R", 0, inGroupId("A")),
+		testMatch("9", "Q", 1, "R", 1, inGroupId("A")),
+	} {
+		m.GroupId = "Z"
+		matches = append(matches, m)
+	}
+	scenarios, err := fifa.ComputeScenarios(matches, &fifa.ScenarioOptions{BestThirds: 1})
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	teams := scenariosByTeam(scenarios)
+	assert.Equal(t, fifa.QualificationOpen, teams["B"].Need("L"), "B goes through as best third unless D beats A")
+	assert.Equal(t, fifa.QualificationOpen, teams["D"].Status)
+	assert.Equal(t, fifa.QualificationClinched, teams["D"].Need("W"))
+	assert.Equal(t, fifa.QualificationOpen, teams["R"].Status, "a heavy defeat could leave D third of group A with one point and a worse goal difference")
+}
+
+func TestComputeScenariosDrawingOfLotsIsOpen(t *testing.T) {
+	t.Parallel()
+	matches := []fifa.MatchResponse{
+		testMatch("1", "A", 0, "B", 0, inGroupId("A")),
+		testMatch("2", "C", 0, "D", 0, inGroupId("A")),
+		testMatch("3", "A", 0, "C", 0, inGroupId("A")),
+		testMatch("4", "B", 0, "D", 0, inGroupId("A")),
+		testMatch("5", "A", 0, "D", 0, inGroupId("A")),
+		testMatch("6", "B", 0, "C", 0, inGroupId("A")),
+	}
+	scenarios, err := fifa.ComputeScenarios(matches, nil)
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	for _, s := range scenarios {
+		assert.Equal(t, fifa.QualificationOpen, s.Status, "%s is level with every team and depends on drawing of lots", s.TeamId)
+	}
+}
+
+func TestComputeScenariosTieAcrossGroupsIsOpen(t *testing.T) {
+	t.Parallel()
+	var matches []fifa.MatchResponse
+	for _, g := range []string{"A", "Z"} {
+		matches = append(matches,
+			testMatch(g+"1", g+"1", 1, g+"2", 0, inGroupId(g)),
+			testMatch(g+"2", g+"2", 1, g+"3", 0, inGroupId(g)),
+			testMatch(g+"3", g+"1", 1, g+"3", 0, inGroupId(g)),
+		)
+	}
+	scenarios, err := fifa.ComputeScenarios(matches, &fifa.ScenarioOptions{Qualifying: 1, BestThirds: 1})
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	teams := scenariosByTeam(scenarios)
+	assert.Equal(t, fifa.QualificationClinched, teams["A1"].Status)
+	assert.Equal(t, fifa.QualificationEliminated, teams["A3"].Status)
+	assert.Equal(t, fifa.QualificationOpen, teams["A2"].Status, "the runners-up cannot be separated")
+	assert.Equal(t, fifa.QualificationOpen, teams["Z2"].Status)
+}
+
+func TestComputeScenariosTooMany(t *testing.T) {
+	t.Parallel()
+	_, err := fifa.ComputeScenarios(scenarioGroup(), &fifa.ScenarioOptions{MaxScenarios: 5})
+	assert.True(t, errors.Is(err, fifa.ErrTooManyScenarios))
+}
+
+func TestComputeScenariosMargins(t *testing.T) {
+	t.Parallel()
+	scenarios, err := fifa.ComputeScenarios(scenarioGroup(), &fifa.ScenarioOptions{Margins: []int{1, 3}})
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, 25, scenariosByTeam(scenarios)["A"].Scenarios)
+}
+
+func TestRankAcrossGroups(t *testing.T) {
+	t.Parallel()
+	rows := []fifa.StandingsResult{
+		{Team: fifa.TeamResponse{Id: "X"}, Points: 3, GoalsDifference: -1},
+		{Team: fifa.TeamResponse{Id: "Y"}, Points: 4},
+		{Team: fifa.TeamResponse{Id: "Z"}, Points: 3, GoalsDifference: 1},
+	}
+	assert.Equal(t, []string{"Y", "Z", "X"}, standingsOrder(fifa.RankAcrossGroups(rows, fifa.WorldCupStandingsRules)))
+}
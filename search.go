@@ -0,0 +1,411 @@
+package go_fifa
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const minFuzzySimilarity = 0.6
+
+type SearchOptions struct {
+	Query string
+	// Limit caps the number of results, 0 returns every match.
+	Limit int
+	// CompetitionId and SeasonId restrict team and player searches to the
+	// squads of a season, which are searched locally. CompetitionId alone
+	// lets the search fall back to the squads of the competition's current
+	// season when the API's search endpoint is not available.
+	CompetitionId string
+	SeasonId      string
+}
+
+type SearchResult struct {
+	Id     string
+	Name   string
+	Locale string
+	Score  float64
+}
+
+type CompetitionSearchResult struct {
+	SearchResult
+	Competition CompetitionResponse
+}
+
+type TeamSearchResult struct {
+	SearchResult
+	Team TeamResponse
+}
+
+type PlayerSearchResult struct {
+	SearchResult
+	Player PlayerResponse
+}
+
+type searchAPIOptions struct {
+	Name  string `url:"name"`
+	Count int    `url:"count"`
+}
+
+type searchTeamsResponse struct {
+	PaginatedResponse
+	Results []TeamResponse `json:"Results"`
+}
+
+type searchPlayersResponse struct {
+	PaginatedResponse
+	Results []PlayerResponse `json:"Results"`
+}
+
+type searchEntry struct {
+	id     string
+	locale string
+	name   string
+	folded string
+	tokens []string
+}
+
+// SearchIndex is an in-memory, accent-insensitive index over localized names.
+type SearchIndex struct {
+	entries []searchEntry
+}
+
+func NewSearchIndex() *SearchIndex {
+	return &SearchIndex{}
+}
+
+// Add indexes every localized name of the item with the given id.
+func (idx *SearchIndex) Add(id string, names ...[]DefaultDescriptionResponse) {
+	for _, list := range names {
+		for _, n := range list {
+			folded := foldSearchText(n.Description)
+			if folded == "" {
+				continue
+			}
+			idx.entries = append(idx.entries, searchEntry{
+				id:     id,
+				locale: n.Locale,
+				name:   n.Description,
+				folded: folded,
+				tokens: strings.Fields(folded),
+			})
+		}
+	}
+}
+
+// Search returns the best match per item, ranked by score. Items whose names
+// neither contain the query nor approximately match it are left out.
+func (idx *SearchIndex) Search(query string, limit int) []SearchResult {
+	q := foldSearchText(query)
+	if q == "" {
+		return nil
+	}
+	qTokens := strings.Fields(q)
+	best := map[string]SearchResult{}
+	var order []string
+	for _, e := range idx.entries {
+		score := scoreSearchEntry(q, qTokens, e)
+		if score == 0 {
+			continue
+		}
+		prev, ok := best[e.id]
+		if !ok {
+			order = append(order, e.id)
+		}
+		if !ok || score > prev.Score {
+			best[e.id] = SearchResult{Id: e.id, Name: e.name, Locale: e.locale, Score: score}
+		}
+	}
+	results := make([]SearchResult, 0, len(order))
+	for _, id := range order {
+		results = append(results, best[id])
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Name < results[j].Name
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+func scoreSearchEntry(q string, qTokens []string, e searchEntry) float64 {
+	switch {
+	case e.folded == q:
+		return 1
+	case strings.HasPrefix(e.folded, q):
+		return 0.9
+	case tokensHavePrefixes(qTokens, e.tokens):
+		return 0.8
+	case strings.Contains(e.folded, q):
+		return 0.7
+	}
+	var total float64
+	for _, qt := range qTokens {
+		var tokenBest float64
+		for _, et := range e.tokens {
+			if s := similarity(qt, et); s > tokenBest {
+				tokenBest = s
+			}
+		}
+		if tokenBest < minFuzzySimilarity {
+			return 0
+		}
+		total += tokenBest
+	}
+	return 0.6 * total / float64(len(qTokens))
+}
+
+func tokensHavePrefixes(query []string, tokens []string) bool {
+	for _, qt := range query {
+		found := false
+		for _, t := range tokens {
+			if strings.HasPrefix(t, qt) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func similarity(a string, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return 0
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a []rune, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(minInt(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// letterFolds spells out letters that have no canonical decomposition, so
+// stripping combining marks leaves them untouched.
+var letterFolds = map[rune]string{
+	'æ': "ae", 'đ': "d", 'ð': "d", 'ı': "i", 'ł': "l", 'ø': "o", 'œ': "oe", 'ß': "ss", 'þ': "th",
+}
+
+// foldSearchText lowercases s, strips diacritics by decomposing it and
+// dropping combining marks, and collapses punctuation into single spaces.
+func foldSearchText(s string) string {
+	var b strings.Builder
+	space := true
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if folded, ok := letterFolds[r]; ok {
+			b.WriteString(folded)
+			space = false
+			continue
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		if !space {
+			b.WriteByte(' ')
+			space = true
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func (c *Client) SearchCompetitions(opts *SearchOptions) ([]CompetitionSearchResult, error) {
+	if opts.Query == "" {
+		return nil, errors.New("query is required but was not provided")
+	}
+	comps, err := c.GetCompetitions()
+	if err != nil {
+		return nil, err
+	}
+	idx := NewSearchIndex()
+	byId := map[string]CompetitionResponse{}
+	for _, comp := range comps {
+		idx.Add(comp.CompetitionId, comp.Name)
+		byId[comp.CompetitionId] = comp
+	}
+	var results []CompetitionSearchResult
+	for _, r := range idx.Search(opts.Query, opts.Limit) {
+		results = append(results, CompetitionSearchResult{SearchResult: r, Competition: byId[r.Id]})
+	}
+	return results, nil
+}
+
+// searchSquads returns the squads searched locally: those of the given
+// season, or of the competition's current season when no season is set.
+func (c *Client) searchSquads(opts *SearchOptions) ([]SquadResponse, error) {
+	seasonId := opts.SeasonId
+	if seasonId == "" {
+		season, err := c.CurrentSeason(opts.CompetitionId)
+		if err != nil {
+			return nil, err
+		}
+		seasonId = season.Id
+	}
+	return c.GetSeasonSquads(&GetSeasonSquadsOptions{CompetitionId: opts.CompetitionId, SeasonId: seasonId})
+}
+
+// searchAPI calls one of the API's search endpoints. If the endpoint is not
+// available it returns fallback=true when a competition is set so the caller
+// searches its squads locally instead; any other error is returned.
+func (c *Client) searchAPI(path string, respData interface{}, opts *SearchOptions) (fallback bool, err error) {
+	_, err = c.get(path, respData, &searchAPIOptions{Name: opts.Query, Count: 100})
+	if err == nil {
+		return false, nil
+	}
+	if !searchUnavailable(err) {
+		return false, err
+	}
+	if opts.CompetitionId == "" {
+		return false, fmt.Errorf("search failed and no competition was set to search locally: %w", err)
+	}
+	return true, nil
+}
+
+// searchUnavailable reports whether err means the search endpoint does not
+// exist or does not support the request.
+func searchUnavailable(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusNotFound, http.StatusMethodNotAllowed:
+		return true
+	}
+	return false
+}
+
+// SearchTeams searches the squads of a season when CompetitionId and SeasonId
+// are set, and the API's team search otherwise, falling back to the squads
+// of CompetitionId's current season if the endpoint is not available.
+func (c *Client) SearchTeams(opts *SearchOptions) ([]TeamSearchResult, error) {
+	if opts.Query == "" {
+		return nil, errors.New("query is required but was not provided")
+	}
+	var teams []TeamResponse
+	local := opts.CompetitionId != "" && opts.SeasonId != ""
+	if !local {
+		var respData searchTeamsResponse
+		fallback, err := c.searchAPI("/teams/search", &respData, opts)
+		if err != nil {
+			return nil, err
+		}
+		local = fallback
+		teams = respData.Results
+	}
+	if local {
+		squads, err := c.searchSquads(opts)
+		if err != nil {
+			return nil, err
+		}
+		teams = nil
+		for _, s := range squads {
+			teams = append(teams, TeamResponse{Id: s.TeamId, Name: s.TeamName})
+		}
+	}
+	idx := NewSearchIndex()
+	byId := map[string]TeamResponse{}
+	for _, t := range teams {
+		idx.Add(t.Id, t.Name)
+		byId[t.Id] = t
+	}
+	var results []TeamSearchResult
+	for _, r := range idx.Search(opts.Query, opts.Limit) {
+		results = append(results, TeamSearchResult{SearchResult: r, Team: byId[r.Id]})
+	}
+	return results, nil
+}
+
+// SearchPlayers searches the squads of a season when CompetitionId and
+// SeasonId are set, and the API's player search otherwise, falling back to
+// the squads of CompetitionId's current season if the endpoint is not available.
+func (c *Client) SearchPlayers(opts *SearchOptions) ([]PlayerSearchResult, error) {
+	if opts.Query == "" {
+		return nil, errors.New("query is required but was not provided")
+	}
+	var players []PlayerResponse
+	local := opts.CompetitionId != "" && opts.SeasonId != ""
+	if !local {
+		var respData searchPlayersResponse
+		fallback, err := c.searchAPI("/players/search", &respData, opts)
+		if err != nil {
+			return nil, err
+		}
+		local = fallback
+		players = respData.Results
+	}
+	if local {
+		squads, err := c.searchSquads(opts)
+		if err != nil {
+			return nil, err
+		}
+		players = nil
+		for _, s := range squads {
+			for _, p := range s.Players {
+				players = append(players, PlayerResponse{
+					Id:        p.Id,
+					Name:      p.Name,
+					Alias:     p.ShortName,
+					CountryId: p.CountryId,
+					Birthdate: p.Birthdate,
+					Height:    p.Height,
+					Weight:    p.Weight,
+				})
+			}
+		}
+	}
+	idx := NewSearchIndex()
+	byId := map[string]PlayerResponse{}
+	for _, p := range players {
+		idx.Add(p.Id, p.Name, p.Alias)
+		byId[p.Id] = p
+	}
+	var results []PlayerSearchResult
+	for _, r := range idx.Search(opts.Query, opts.Limit) {
+		results = append(results, PlayerSearchResult{SearchResult: r, Player: byId[r.Id]})
+	}
+	return results, nil
+}
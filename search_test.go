@@ -0,0 +1,169 @@
+package go_fifa_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func names(values ...string) []fifa.DefaultDescriptionResponse {
+	var out []fifa.DefaultDescriptionResponse
+	for i := 0; i+1 < len(values); i += 2 {
+		out = append(out, fifa.DefaultDescriptionResponse{Locale: values[i], Description: values[i+1]})
+	}
+	return out
+}
+
+func TestSearchIndex(t *testing.T) {
+	t.Parallel()
+	idx := fifa.NewSearchIndex()
+	idx.Add("1", names("en-GB", "Edgar HERNÁNDEZ"))
+	idx.Add("2", names("en-GB", "Javier HERNANDEZ", "es-ES", "Chicharito"))
+	idx.Add("3", names("en-GB", "Germany", "de-DE", "Deutschland"))
+	idx.Add("4", names("en-GB", "Côte d'Ivoire"))
+
+	results := idx.Search("hernandez", 0)
+	if ok := assert.Len(t, results, 2); !ok {
+		t.FailNow()
+	}
+
+	results = idx.Search("javier hernandez", 0)
+	if ok := assert.NotEmpty(t, results); ok {
+		assert.Equal(t, "2", results[0].Id)
+		assert.Equal(t, 1.0, results[0].Score)
+	}
+
+	results = idx.Search("deutsch", 0)
+	if ok := assert.Len(t, results, 1); ok {
+		assert.Equal(t, "de-DE", results[0].Locale)
+	}
+
+	results = idx.Search("cote divoire", 0)
+	if ok := assert.Len(t, results, 1); ok {
+		assert.Equal(t, "4", results[0].Id)
+	}
+
+	results = idx.Search("Germny", 0)
+	if ok := assert.Len(t, results, 1, "expected fuzzy match"); ok {
+		assert.Equal(t, "3", results[0].Id)
+		assert.Less(t, results[0].Score, 0.7)
+	}
+
+	idx.Add("5", names("en-GB", "Martin ØDEGAARD"), names("de-DE", "Mesut O\u0308ZIL"))
+	results = idx.Search("odegaard", 0)
+	if ok := assert.Len(t, results, 1); ok {
+		assert.Equal(t, "5", results[0].Id)
+	}
+	results = idx.Search("Mesut Özil", 0)
+	if ok := assert.Len(t, results, 1, "decomposed and precomposed accents should match"); ok {
+		assert.Equal(t, 1.0, results[0].Score)
+	}
+
+	assert.Empty(t, idx.Search("brazil", 0))
+	assert.Len(t, idx.Search("e", 1), 1)
+}
+
+func TestSearchCompetitions(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: &stubHTTPClient{responses: map[string]stubResponse{
+		"/competitions": {Body: `{"Results":[
+			{"IdCompetition":"17","Name":[{"Locale":"en-GB","Description":"FIFA World Cup™"}]},
+			{"IdCompetition":"103","Name":[{"Locale":"en-GB","Description":"FIFA Women's World Cup™"}]},
+			{"IdCompetition":"107","Name":[{"Locale":"en-GB","Description":"FIFA Club World Cup"}]}
+		]}`},
+	}}}
+	results, err := client.SearchCompetitions(&fifa.SearchOptions{Query: "fifa world cup"})
+	if ok := assert.Nil(t, err, "expected no error with SearchCompetitions, got: %s", err); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Len(t, results, 3); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "17", results[0].Competition.CompetitionId)
+}
+
+func TestSearchPlayersInSeason(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: &stubHTTPClient{responses: map[string]stubResponse{
+		"/teams/squads/all/17/255711": {Body: `{"Results":[
+			{"IdTeam":"43922","Players":[{"IdPlayer":"229397","PlayerName":[{"Locale":"en-GB","Description":"Lionel MESSI"}]}]},
+			{"IdTeam":"43924","Players":[{"IdPlayer":"201200","PlayerName":[{"Locale":"en-GB","Description":"NEYMAR"}]}]}
+		]}`},
+	}}}
+	results, err := client.SearchPlayers(&fifa.SearchOptions{Query: "messi", CompetitionId: "17", SeasonId: "255711"})
+	if ok := assert.Nil(t, err, "expected no error with SearchPlayers, got: %s", err); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Len(t, results, 1); ok {
+		assert.Equal(t, "229397", results[0].Player.Id)
+	}
+}
+
+func TestSearchTeamsFallsBackToSquads(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{responses: map[string]stubResponse{
+		"/seasons": {Body: `{"Results":[
+			{"IdSeason":"old","StartDate":"2018-06-14T00:00:00Z","EndDate":"2018-07-15T00:00:00Z"},
+			{"IdSeason":"255711","StartDate":"2022-11-20T00:00:00Z","EndDate":"2022-12-18T00:00:00Z"}
+		]}`},
+		"/teams/squads/all/17/255711": {Body: `{"Results":[
+			{"IdTeam":"43922","TeamName":[{"Locale":"en-GB","Description":"Argentina"}]},
+			{"IdTeam":"43924","TeamName":[{"Locale":"en-GB","Description":"Brazil"}]}
+		]}`},
+	}}
+	client := fifa.Client{Client: stub}
+	results, err := client.SearchTeams(&fifa.SearchOptions{Query: "argentina", CompetitionId: "17"})
+	if ok := assert.Nil(t, err, "expected no error with SearchTeams, got: %s", err); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Len(t, results, 1); ok {
+		assert.Equal(t, "43922", results[0].Team.Id)
+	}
+	assert.Equal(t, "/api/v3/teams/search", stub.requests[0].URL.Path, "the API's search should be tried first")
+
+	_, err = client.SearchTeams(&fifa.SearchOptions{Query: "argentina"})
+	var apiErr *fifa.APIError
+	assert.True(t, errors.As(err, &apiErr), "expected the API error without a competition to fall back to, got: %v", err)
+}
+
+func TestSearchPlayersFallsBackToSquads(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: &stubHTTPClient{responses: map[string]stubResponse{
+		"/seasons": {Body: `{"Results":[{"IdSeason":"255711","StartDate":"2022-11-20T00:00:00Z"}]}`},
+		"/teams/squads/all/17/255711": {Body: `{"Results":[
+			{"IdTeam":"43922","Players":[{"IdPlayer":"229397","PlayerName":[{"Locale":"en-GB","Description":"Lionel MESSI"}]}]}
+		]}`},
+	}}}
+	results, err := client.SearchPlayers(&fifa.SearchOptions{Query: "messi", CompetitionId: "17"})
+	if ok := assert.Nil(t, err, "expected no error with SearchPlayers, got: %s", err); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Len(t, results, 1); ok {
+		assert.Equal(t, "229397", results[0].Player.Id)
+	}
+}
+
+func TestSearchPlayersReturnsUpstreamErrors(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{responses: map[string]stubResponse{
+		"/players/search": {Status: http.StatusInternalServerError},
+		"/seasons":        {Body: `{"Results":[{"IdSeason":"255711","StartDate":"2022-11-20T00:00:00Z"}]}`},
+	}}
+	client := fifa.Client{
+		Client:  stub,
+		Breaker: fifa.NewCircuitBreaker(&fifa.CircuitBreakerOptions{FailureThreshold: 1, CoolDown: time.Hour}),
+	}
+	_, err := client.SearchPlayers(&fifa.SearchOptions{Query: "messi", CompetitionId: "17"})
+	var apiErr *fifa.APIError
+	if ok := assert.True(t, errors.As(err, &apiErr), "expected APIError, got: %v", err); ok {
+		assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+	}
+
+	_, err = client.SearchPlayers(&fifa.SearchOptions{Query: "messi", CompetitionId: "17"})
+	assert.True(t, errors.Is(err, fifa.ErrCircuitOpen), "expected ErrCircuitOpen, got: %v", err)
+	assert.Equal(t, 1, stub.requestCount(), "the squads should not be searched when the API fails")
+}
@@ -1,11 +1,28 @@
 package go_fifa
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
 
 type GetSeasonOptions struct {
 	SeasonId string
 }
 
+type GetSeasonsResponse struct {
+	PaginatedResponse
+	Results []SeasonResponse `json:"Results"`
+}
+
+type GetSeasonsOptions struct {
+	CompetitionId     string `url:"IdCompetition"`
+	Count             int    `url:"Count"`
+	ContinuationToken string `url:"ContinuationToken,omitempty"`
+	Descending        bool   `url:"-"`
+}
+
 func (c *Client) GetSeason(options *GetSeasonOptions) (*SeasonResponse, error) {
 	var season SeasonResponse
 	url := fmt.Sprintf("/seasons/%s", options.SeasonId)
@@ -15,3 +32,81 @@ func (c *Client) GetSeason(options *GetSeasonOptions) (*SeasonResponse, error) {
 	}
 	return &season, nil
 }
+
+// GetSeasons returns every season of a competition, following continuation
+// tokens until all pages have been read, sorted by StartDate.
+func (c *Client) GetSeasons(options *GetSeasonsOptions) ([]SeasonResponse, error) {
+	if options.CompetitionId == "" {
+		return nil, errors.New("competitionId is required but was not provided")
+	}
+	if options.Count == 0 {
+		options.Count = 500
+	}
+	pageOptions := *options
+	var seasons []SeasonResponse
+	err := getAllPages(&pageOptions.ContinuationToken, func() (string, int, error) {
+		var respData GetSeasonsResponse
+		if _, err := c.get("/seasons", &respData, &pageOptions); err != nil {
+			return "", 0, err
+		}
+		seasons = append(seasons, respData.Results...)
+		return respData.ContinuationToken, len(respData.Results), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(seasons, func(i, j int) bool {
+		if options.Descending {
+			return seasons[i].StartDate.After(seasons[j].StartDate)
+		}
+		return seasons[i].StartDate.Before(seasons[j].StartDate)
+	})
+	return seasons, nil
+}
+
+// CurrentSeason returns the season of a competition that is in progress, or
+// the most recently started one if none is.
+func (c *Client) CurrentSeason(competitionId string) (*SeasonResponse, error) {
+	seasons, err := c.GetSeasons(&GetSeasonsOptions{CompetitionId: competitionId, Descending: true})
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var latest *SeasonResponse
+	for i := range seasons {
+		s := &seasons[i]
+		if s.StartDate.After(now) {
+			continue
+		}
+		if !s.EndDate.IsZero() && !s.EndDate.Before(now) {
+			return s, nil
+		}
+		if latest == nil {
+			latest = s
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("no current season found for competition %s", competitionId)
+	}
+	return latest, nil
+}
+
+// SeasonByYear returns the season of a competition that starts in the given
+// year, falling back to a season whose dates span that year.
+func (c *Client) SeasonByYear(competitionId string, year int) (*SeasonResponse, error) {
+	seasons, err := c.GetSeasons(&GetSeasonsOptions{CompetitionId: competitionId})
+	if err != nil {
+		return nil, err
+	}
+	for i := range seasons {
+		if seasons[i].StartDate.Year() == year {
+			return &seasons[i], nil
+		}
+	}
+	for i := range seasons {
+		if seasons[i].StartDate.Year() < year && seasons[i].EndDate.Year() >= year {
+			return &seasons[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no season found for competition %s in %d", competitionId, year)
+}
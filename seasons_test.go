@@ -17,3 +17,49 @@ func TestGetSeason(t *testing.T) {
 		t.FailNow()
 	}
 }
+
+func seasonsStub() *stubHTTPClient {
+	return &stubHTTPClient{responses: map[string]stubResponse{
+		"/seasons?Count=500&IdCompetition=17": {Body: `{"ContinuationToken":"page2","Results":[
+			{"IdSeason":"255711","IdCompetition":"17","StartDate":"2018-06-14T00:00:00Z","EndDate":"2018-07-15T00:00:00Z"},
+			{"IdSeason":"2000","IdCompetition":"17","StartDate":"2000-01-01T00:00:00Z","EndDate":"2999-12-31T00:00:00Z"}
+		]}`},
+		"/seasons?ContinuationToken=page2&Count=500&IdCompetition=17": {Body: `{"Results":[
+			{"IdSeason":"249722","IdCompetition":"17","StartDate":"2014-06-12T00:00:00Z","EndDate":"2014-07-13T00:00:00Z"}
+		]}`},
+	}}
+}
+
+func TestGetSeasons(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: seasonsStub()}
+	resp, err := client.GetSeasons(&fifa.GetSeasonsOptions{CompetitionId: "17"})
+	if ok := assert.Nil(t, err, "expected no error with GetSeasons, got: %s", err); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Len(t, resp, 3, "expected both pages to be read"); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "2000", resp[0].Id)
+	assert.Equal(t, "249722", resp[1].Id)
+	assert.Equal(t, "255711", resp[2].Id)
+}
+
+func TestSeasonHelpers(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: seasonsStub()}
+	current, err := client.CurrentSeason("17")
+	if ok := assert.Nil(t, err, "expected no error with CurrentSeason, got: %s", err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "2000", current.Id)
+
+	season, err := client.SeasonByYear("17", 2014)
+	if ok := assert.Nil(t, err, "expected no error with SeasonByYear, got: %s", err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "249722", season.Id)
+
+	_, err = client.SeasonByYear("17", 1930)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,216 @@
+package go_fifa
+
+const shootoutRegulationKicks = 5
+
+type KickOutcome int
+
+const (
+	KickScored KickOutcome = 1
+	KickMissed KickOutcome = 2
+	KickSaved  KickOutcome = 3
+)
+
+func (o KickOutcome) String() string {
+	switch o {
+	case KickScored:
+		return "scored"
+	case KickMissed:
+		return "missed"
+	case KickSaved:
+		return "saved"
+	}
+	return "unknown"
+}
+
+type ShootoutKick struct {
+	// Number is the position of the kick in the shootout, starting at 1.
+	Number int
+	// Round is the number of kicks the kicker's team has taken, including
+	// this one.
+	Round     int
+	TeamId    string
+	PlayerId  string
+	Side      string
+	Outcome   KickOutcome
+	GoalGateX float32
+	GoalGateY float32
+	GoalGateZ float32
+	HomeScore int
+	AwayScore int
+	// SuddenDeath is set on kicks taken after each team's first five.
+	SuddenDeath bool
+	// Deciding is set on the kick after which the shootout could no longer
+	// be won by the other team.
+	Deciding bool
+}
+
+type Shootout struct {
+	HomeTeamId string
+	AwayTeamId string
+	Kicks      []ShootoutKick
+	HomeScore  int
+	AwayScore  int
+	WinnerId   string
+}
+
+func NewShootout(events *GetMatchEventsResponse, home *TeamResponse, away *TeamResponse) *Shootout {
+	return NewTimeline(events, home, away).Shootout()
+}
+
+// Shootout reconstructs the penalty shootout from the timeline's SHOOTOUT
+// period events. It returns nil if the match had no shootout.
+func (t *Timeline) Shootout() *Shootout {
+	s := &Shootout{HomeTeamId: t.HomeTeamId, AwayTeamId: t.AwayTeamId}
+	var homePens, awayPens int
+	for _, e := range t.Entries {
+		ev := e.Event
+		if ev.Period != SHOOTOUT {
+			continue
+		}
+		var outcome KickOutcome
+		switch ev.Type {
+		case PenaltyGoal, GoalScore:
+			outcome = KickScored
+		case PenaltyMissed, PenaltyMissed2:
+			outcome = KickMissed
+		case GoalieSaved:
+			outcome = KickSaved
+		default:
+			continue
+		}
+		side := t.sideOf(ev.TeamId)
+		if side == "" && outcome == KickScored {
+			switch {
+			case ev.HomePenaltyGoals > homePens:
+				side = HomeSide
+			case ev.AwayPenaltyGoals > awayPens:
+				side = AwaySide
+			}
+		}
+		if ev.HomePenaltyGoals > homePens {
+			homePens = ev.HomePenaltyGoals
+		}
+		if ev.AwayPenaltyGoals > awayPens {
+			awayPens = ev.AwayPenaltyGoals
+		}
+		// A missed kick may be logged as both a miss and a save.
+		if n := len(s.Kicks); n > 0 && outcome != KickScored {
+			last := &s.Kicks[n-1]
+			if last.PlayerId == ev.PlayerId && last.TeamId == ev.TeamId && last.Outcome != KickScored {
+				if outcome == KickSaved {
+					last.Outcome = KickSaved
+				}
+				continue
+			}
+		}
+		s.Kicks = append(s.Kicks, ShootoutKick{
+			TeamId:    ev.TeamId,
+			PlayerId:  ev.PlayerId,
+			Side:      side,
+			Outcome:   outcome,
+			GoalGateX: ev.GoalGatePositionX,
+			GoalGateY: ev.GoalGatePositionY,
+			GoalGateZ: ev.GoalGatePositionZ,
+		})
+	}
+	if len(s.Kicks) == 0 {
+		return nil
+	}
+	s.inferSides()
+	s.score()
+	return s
+}
+
+// inferSides fills in unknown kick sides from their neighbours, since teams
+// alternate kicks.
+func (s *Shootout) inferSides() {
+	for pass := 0; pass < 2; pass++ {
+		for i := range s.Kicks {
+			if s.Kicks[i].Side != "" {
+				continue
+			}
+			if i > 0 && s.Kicks[i-1].Side != "" {
+				s.Kicks[i].Side = opposite(s.Kicks[i-1].Side)
+			} else if i+1 < len(s.Kicks) && s.Kicks[i+1].Side != "" {
+				s.Kicks[i].Side = opposite(s.Kicks[i+1].Side)
+			}
+		}
+	}
+	for i := range s.Kicks {
+		k := &s.Kicks[i]
+		if k.TeamId == "" {
+			switch k.Side {
+			case HomeSide:
+				k.TeamId = s.HomeTeamId
+			case AwaySide:
+				k.TeamId = s.AwayTeamId
+			}
+		}
+	}
+}
+
+func (s *Shootout) score() {
+	var homeKicks, awayKicks int
+	decided := false
+	for i := range s.Kicks {
+		k := &s.Kicks[i]
+		k.Number = i + 1
+		switch k.Side {
+		case HomeSide:
+			homeKicks++
+			k.Round = homeKicks
+			if k.Outcome == KickScored {
+				s.HomeScore++
+			}
+		case AwaySide:
+			awayKicks++
+			k.Round = awayKicks
+			if k.Outcome == KickScored {
+				s.AwayScore++
+			}
+		}
+		k.HomeScore = s.HomeScore
+		k.AwayScore = s.AwayScore
+		k.SuddenDeath = k.Round > shootoutRegulationKicks
+		if !decided && shootoutDecided(s.HomeScore, s.AwayScore, homeKicks, awayKicks) {
+			k.Deciding = true
+			decided = true
+		}
+	}
+	switch {
+	case s.HomeScore > s.AwayScore:
+		s.WinnerId = s.HomeTeamId
+	case s.AwayScore > s.HomeScore:
+		s.WinnerId = s.AwayTeamId
+	}
+}
+
+func shootoutDecided(home int, away int, homeKicks int, awayKicks int) bool {
+	if homeKicks <= shootoutRegulationKicks && awayKicks <= shootoutRegulationKicks {
+		homeLeft := shootoutRegulationKicks - homeKicks
+		awayLeft := shootoutRegulationKicks - awayKicks
+		return home > away+awayLeft || away > home+homeLeft
+	}
+	return homeKicks == awayKicks && home != away
+}
+
+// SuddenDeath reports whether the shootout went beyond five kicks each.
+func (s *Shootout) SuddenDeath() bool {
+	for _, k := range s.Kicks {
+		if k.SuddenDeath {
+			return true
+		}
+	}
+	return false
+}
+
+// DecidingKick returns the kick that settled the shootout, or nil if it is
+// still undecided.
+func (s *Shootout) DecidingKick() *ShootoutKick {
+	for i := range s.Kicks {
+		if s.Kicks[i].Deciding {
+			return &s.Kicks[i]
+		}
+	}
+	return nil
+}
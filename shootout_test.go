@@ -0,0 +1,81 @@
+package go_fifa_test
+
+import (
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func kick(team string, player string, typ fifa.MatchEvent, homePens int, awayPens int) fifa.EventResponse {
+	return fifa.EventResponse{
+		Type:             typ,
+		Period:           fifa.SHOOTOUT,
+		TeamId:           team,
+		PlayerId:         player,
+		HomePenaltyGoals: homePens,
+		AwayPenaltyGoals: awayPens,
+	}
+}
+
+func TestShootout(t *testing.T) {
+	t.Parallel()
+	events := &fifa.GetMatchEventsResponse{Events: []fifa.EventResponse{
+		timelineEvent(t, fifa.GoalScore, fifa.SECOND_EXTRA, "108'", "ARG", "messi", 3, 2),
+		kick("FRA", "mbappe", fifa.PenaltyGoal, 0, 1),
+		kick("ARG", "messi", fifa.PenaltyGoal, 1, 1),
+		kick("FRA", "coman", fifa.PenaltyMissed, 1, 1),
+		kick("FRA", "coman", fifa.GoalieSaved, 1, 1),
+		kick("ARG", "dybala", fifa.PenaltyGoal, 2, 1),
+		kick("FRA", "tchouameni", fifa.PenaltyMissed, 2, 1),
+		kick("ARG", "paredes", fifa.PenaltyGoal, 3, 1),
+		kick("FRA", "kolomuani", fifa.PenaltyGoal, 3, 2),
+		kick("ARG", "montiel", fifa.PenaltyGoal, 4, 2),
+	}}
+	events.Events[len(events.Events)-1].GoalGatePositionY = 0.3
+	home := &fifa.TeamResponse{Id: "ARG"}
+	away := &fifa.TeamResponse{Id: "FRA"}
+	s := fifa.NewShootout(events, home, away)
+	if ok := assert.NotNil(t, s); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Len(t, s.Kicks, 8, "miss and save of the same kick should merge"); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, fifa.KickSaved, s.Kicks[2].Outcome)
+	assert.Equal(t, fifa.KickMissed, s.Kicks[4].Outcome)
+	assert.Equal(t, 4, s.HomeScore)
+	assert.Equal(t, 2, s.AwayScore)
+	assert.Equal(t, "ARG", s.WinnerId)
+	assert.False(t, s.SuddenDeath())
+	deciding := s.DecidingKick()
+	if ok := assert.NotNil(t, deciding); ok {
+		assert.Equal(t, "montiel", deciding.PlayerId)
+		assert.Equal(t, 8, deciding.Number)
+		assert.Equal(t, 4, deciding.Round)
+		assert.Equal(t, float32(0.3), deciding.GoalGateY)
+	}
+
+	h, a := fifa.NewTimeline(events, home, away).ShootoutScore()
+	assert.Equal(t, [2]int{4, 2}, [2]int{h, a})
+}
+
+func TestShootoutSuddenDeath(t *testing.T) {
+	t.Parallel()
+	var evs []fifa.EventResponse
+	for i := 0; i < 5; i++ {
+		evs = append(evs, kick("", "h", fifa.PenaltyGoal, i+1, i), kick("", "a", fifa.PenaltyGoal, i+1, i+1))
+	}
+	evs = append(evs, kick("", "h6", fifa.PenaltyGoal, 6, 5), kick("", "a6", fifa.GoalieSaved, 6, 5))
+	s := fifa.NewShootout(&fifa.GetMatchEventsResponse{Events: evs}, &fifa.TeamResponse{Id: "H"}, &fifa.TeamResponse{Id: "A"})
+	if ok := assert.NotNil(t, s); !ok {
+		t.FailNow()
+	}
+	assert.True(t, s.SuddenDeath())
+	assert.Equal(t, fifa.AwaySide, s.Kicks[11].Side, "sides should be inferred from alternation")
+	assert.Equal(t, "A", s.Kicks[11].TeamId)
+	assert.Equal(t, "a6", s.DecidingKick().PlayerId)
+	assert.Equal(t, "H", s.WinnerId)
+
+	assert.Nil(t, fifa.NewShootout(&fifa.GetMatchEventsResponse{}, nil, nil))
+}
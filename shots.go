@@ -0,0 +1,325 @@
+package go_fifa
+
+import "math"
+
+const (
+	defaultPitchLength = 105
+	defaultPitchWidth  = 68
+	defaultShotScale   = 100
+	goalWidth          = 7.32
+	goalHeight         = 2.44
+)
+
+type ShotOutcome int
+
+const (
+	OutcomeGoal      ShotOutcome = 1
+	OutcomeSaved     ShotOutcome = 2
+	OutcomeBlocked   ShotOutcome = 3
+	OutcomeOffTarget ShotOutcome = 4
+	OutcomeWoodwork  ShotOutcome = 5
+)
+
+func (o ShotOutcome) String() string {
+	switch o {
+	case OutcomeGoal:
+		return "goal"
+	case OutcomeSaved:
+		return "saved"
+	case OutcomeBlocked:
+		return "blocked"
+	case OutcomeOffTarget:
+		return "off target"
+	case OutcomeWoodwork:
+		return "woodwork"
+	}
+	return "unknown"
+}
+
+// AttackDirection tells which end of the pitch the raw coordinates of each
+// team's shots point at.
+type AttackDirection int
+
+const (
+	// DirectionInferred works out which end the home team attacks in every
+	// period from where its shots were taken, since most shots come from the
+	// attacking half. Periods without shots swap ends with their pair.
+	DirectionInferred AttackDirection = iota
+	// DirectionHomeRightFirst has the home team attack towards raw
+	// X = Scale in the first half and first half of extra time.
+	DirectionHomeRightFirst
+	// DirectionHomeLeftFirst has the home team attack towards raw X = 0 in
+	// the first half and first half of extra time.
+	DirectionHomeLeftFirst
+	// DirectionNormalized treats coordinates as already pointing every team
+	// towards raw X = Scale.
+	DirectionNormalized
+)
+
+// ShotOptions describe the coordinate system of the events, which the API
+// does not document.
+type ShotOptions struct {
+	// PitchLength and PitchWidth are the dimensions in metres coordinates
+	// are scaled to. Default to 105 and 68.
+	PitchLength float64
+	PitchWidth  float64
+	// Scale is the range of PositionX along the pitch and PositionY across
+	// it, which run from 0 to Scale. Defaults to 100.
+	Scale float64
+	// GoalMouthScale is the range of GoalGatePositionY from post to post and
+	// GoalGatePositionZ from the ground to the crossbar. Defaults to 100.
+	GoalMouthScale float64
+	Direction      AttackDirection
+	// IncludeShootout keeps the kicks of a penalty shootout.
+	IncludeShootout bool
+}
+
+type Shot struct {
+	Event    EventResponse
+	Minute   MatchMinute
+	TeamId   string
+	PlayerId string
+	Side     string
+	Outcome  ShotOutcome
+	Penalty  bool
+	FreeKick bool
+	// Disallowed is set on goals that were later ruled out.
+	Disallowed bool
+	// X is the distance in metres from the shooting team's own goal line,
+	// attacking towards X = PitchLength, and Y the distance from the
+	// touchline on its left. Shots of both teams share this frame.
+	X float64
+	Y float64
+	// Distance is in metres to the centre of the goal and Angle is the angle
+	// in degrees between the posts as seen from the shot.
+	Distance float64
+	Angle    float64
+	// GoalMouthY is the distance in metres from the left post, as seen by
+	// the shooter, and GoalMouthZ the height, where the ball crossed the goal
+	// line. They are only set when HasPlacement is.
+	HasPlacement bool
+	GoalMouthY   float64
+	GoalMouthZ   float64
+}
+
+func (s Shot) OnTarget() bool {
+	return s.Outcome == OutcomeGoal || s.Outcome == OutcomeSaved
+}
+
+// Placement describes where the ball crossed the goal line, e.g. "high left",
+// "wide right" or "over", or returns "" when it is not known.
+func (s Shot) Placement() string {
+	if !s.HasPlacement {
+		return ""
+	}
+	switch {
+	case s.GoalMouthY < 0:
+		return "wide left"
+	case s.GoalMouthY > goalWidth:
+		return "wide right"
+	case s.GoalMouthZ > goalHeight:
+		return "over"
+	}
+	vertical := "low"
+	if s.GoalMouthZ > goalHeight/2 {
+		vertical = "high"
+	}
+	horizontal := "centre"
+	switch {
+	case s.GoalMouthY < goalWidth/3:
+		horizontal = "left"
+	case s.GoalMouthY > goalWidth*2/3:
+		horizontal = "right"
+	}
+	return vertical + " " + horizontal
+}
+
+func (o *ShotOptions) withDefaults() ShotOptions {
+	out := ShotOptions{}
+	if o != nil {
+		out = *o
+	}
+	if out.PitchLength <= 0 {
+		out.PitchLength = defaultPitchLength
+	}
+	if out.PitchWidth <= 0 {
+		out.PitchWidth = defaultPitchWidth
+	}
+	if out.Scale <= 0 {
+		out.Scale = defaultShotScale
+	}
+	if out.GoalMouthScale <= 0 {
+		out.GoalMouthScale = defaultShotScale
+	}
+	return out
+}
+
+// shotOutcome returns the outcome of a shot-type event and whether it was a
+// penalty and a free kick.
+func shotOutcome(typ MatchEvent) (outcome ShotOutcome, penalty bool, freeKick bool) {
+	switch typ {
+	case GoalScore:
+		return OutcomeGoal, false, false
+	case FreeKickGoal:
+		return OutcomeGoal, false, true
+	case PenaltyGoal:
+		return OutcomeGoal, true, false
+	case GoalieSaved:
+		return OutcomeSaved, false, false
+	case ShotBlocked:
+		return OutcomeBlocked, false, false
+	case GoalAttempt:
+		return OutcomeOffTarget, false, false
+	case PenaltyMissed, PenaltyMissed2:
+		return OutcomeOffTarget, true, false
+	case Crossbar, Crossbar2:
+		return OutcomeWoodwork, false, false
+	case FreeKickCrossbar, FreeKickPost:
+		return OutcomeWoodwork, false, true
+	}
+	return 0, false, false
+}
+
+// pairedPeriod returns the period in which teams play towards the other end.
+func pairedPeriod(p PeriodEnum) PeriodEnum {
+	switch p {
+	case FIRST:
+		return SECOND
+	case SECOND:
+		return FIRST
+	case FIRST_EXTRA:
+		return SECOND_EXTRA
+	case SECOND_EXTRA:
+		return FIRST_EXTRA
+	}
+	return 0
+}
+
+// Shots returns the shots of the match in playing order with coordinates
+// normalized so every shot attacks towards X = PitchLength. Own goals are not
+// shots and are left out.
+func (t *Timeline) Shots(opts *ShotOptions) []Shot {
+	o := opts.withDefaults()
+	mid := o.Scale / 2
+
+	// votes counts, per period, the shots suggesting the home team attacks
+	// towards raw X = Scale against those suggesting the opposite.
+	votes := map[PeriodEnum]int{}
+	for _, e := range t.Entries {
+		outcome, penalty, _ := shotOutcome(e.Event.Type)
+		if outcome == 0 || penalty || e.Event.Period == SHOOTOUT {
+			continue
+		}
+		right := float64(e.Event.PositionX) > mid
+		switch e.Side {
+		case HomeSide:
+			if right {
+				votes[e.Event.Period]++
+			} else {
+				votes[e.Event.Period]--
+			}
+		case AwaySide:
+			if right {
+				votes[e.Event.Period]--
+			} else {
+				votes[e.Event.Period]++
+			}
+		}
+	}
+	homeRight := func(period PeriodEnum) (bool, bool) {
+		switch o.Direction {
+		case DirectionHomeRightFirst, DirectionHomeLeftFirst:
+			first := o.Direction == DirectionHomeRightFirst
+			switch period {
+			case FIRST, FIRST_EXTRA:
+				return first, true
+			case SECOND, SECOND_EXTRA:
+				return !first, true
+			}
+			return false, false
+		}
+		if v := votes[period]; v != 0 {
+			return v > 0, true
+		}
+		if v := votes[pairedPeriod(period)]; v != 0 {
+			return v < 0, true
+		}
+		return false, false
+	}
+
+	var shots []Shot
+	for _, e := range t.Entries {
+		ev := e.Event
+		outcome, penalty, freeKick := shotOutcome(ev.Type)
+		if outcome == 0 || ev.Period == SHOOTOUT && !o.IncludeShootout {
+			continue
+		}
+		rawX, rawY := float64(ev.PositionX), float64(ev.PositionY)
+		right := true
+		if o.Direction != DirectionNormalized {
+			// Shots whose direction is unknown, and shoot-out kicks, are
+			// assumed to be aimed at the nearer goal.
+			right = rawX >= mid
+			if home, ok := homeRight(ev.Period); ok && ev.Period != SHOOTOUT {
+				switch e.Side {
+				case HomeSide:
+					right = home
+				case AwaySide:
+					right = !home
+				}
+			}
+		}
+		x, y := rawX/o.Scale*o.PitchLength, rawY/o.Scale*o.PitchWidth
+		gy := float64(ev.GoalGatePositionY) / o.GoalMouthScale * goalWidth
+		if !right {
+			x, y = o.PitchLength-x, o.PitchWidth-y
+			gy = goalWidth - gy
+		}
+		s := Shot{
+			Event:      ev,
+			Minute:     e.Minute,
+			TeamId:     ev.TeamId,
+			PlayerId:   ev.PlayerId,
+			Side:       e.Side,
+			Outcome:    outcome,
+			Penalty:    penalty,
+			FreeKick:   freeKick,
+			Disallowed: e.Disallowed,
+			X:          x,
+			Y:          y,
+		}
+		dx, dy := o.PitchLength-x, y-o.PitchWidth/2
+		s.Distance = math.Hypot(dx, dy)
+		if angle := math.Atan2(goalWidth*dx, dx*dx+dy*dy-goalWidth*goalWidth/4); angle > 0 {
+			s.Angle = angle * 180 / math.Pi
+		}
+		if ev.GoalGatePositionY != 0 || ev.GoalGatePositionZ != 0 {
+			s.HasPlacement = true
+			s.GoalMouthY = gy
+			s.GoalMouthZ = float64(ev.GoalGatePositionZ) / o.GoalMouthScale * goalHeight
+		}
+		shots = append(shots, s)
+	}
+	return shots
+}
+
+// ExtractShots returns the shots of a match. The home and away teams are
+// optional, as for NewTimeline, but without them the attacking direction of
+// shots by teams that did not score falls back to the nearer goal.
+func ExtractShots(events *GetMatchEventsResponse, home *TeamResponse, away *TeamResponse, opts *ShotOptions) []Shot {
+	return NewTimeline(events, home, away).Shots(opts)
+}
+
+// GetMatchShots returns the shots of a match, fetching its teams along with
+// its events.
+func (c *Client) GetMatchShots(ref *MatchRef, opts *ShotOptions) ([]Shot, error) {
+	data, err := c.GetMatchData(ref)
+	if err != nil {
+		return nil, err
+	}
+	events, err := c.GetMatchEvents(ref)
+	if err != nil {
+		return nil, err
+	}
+	return ExtractShots(events, &data.HomeTeam, &data.AwayTeam, opts), nil
+}
@@ -0,0 +1,142 @@
+package go_fifa_test
+
+import (
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func shotEvent(t *testing.T, typ fifa.MatchEvent, period fifa.PeriodEnum, m string, team string, x float32, y float32, home int, away int) fifa.EventResponse {
+	ev := timelineEvent(t, typ, period, m, team, team+"9", home, away)
+	ev.PositionX = x
+	ev.PositionY = y
+	return ev
+}
+
+func shotsFixture(t *testing.T) *fifa.GetMatchEventsResponse {
+	goal := shotEvent(t, fifa.GoalScore, fifa.FIRST, "10'", "ARG", 90, 50, 1, 0)
+	goal.GoalGatePositionY = 10
+	goal.GoalGatePositionZ = 80
+	saved := shotEvent(t, fifa.GoalieSaved, fifa.SECOND, "60'", "ARG", 10, 30, 1, 0)
+	saved.GoalGatePositionY = 50
+	saved.GoalGatePositionZ = 20
+	return &fifa.GetMatchEventsResponse{Events: []fifa.EventResponse{
+		goal,
+		shotEvent(t, fifa.GoalAttempt, fifa.FIRST, "20'", "FRA", 20, 40, 1, 0),
+		shotEvent(t, fifa.ShotBlocked, fifa.FIRST, "30'", "ARG", 80, 60, 1, 0),
+		saved,
+		shotEvent(t, fifa.Crossbar, fifa.SECOND, "70'", "FRA", 85, 50, 1, 0),
+		shotEvent(t, fifa.OwnGoal, fifa.SECOND, "80'", "FRA", 5, 50, 2, 0),
+		shotEvent(t, fifa.Foul, fifa.SECOND, "81'", "FRA", 50, 50, 2, 0),
+		shotEvent(t, fifa.PenaltyGoal, fifa.SHOOTOUT, "", "ARG", 89, 50, 2, 0),
+	}}
+}
+
+func TestExtractShots(t *testing.T) {
+	t.Parallel()
+	shots := fifa.ExtractShots(shotsFixture(t), &fifa.TeamResponse{Id: "ARG"}, &fifa.TeamResponse{Id: "FRA"}, nil)
+	if ok := assert.Len(t, shots, 5, "own goals, other events and shoot-out kicks should be left out"); !ok {
+		t.FailNow()
+	}
+
+	goal := shots[0]
+	assert.Equal(t, fifa.OutcomeGoal, goal.Outcome)
+	assert.Equal(t, "goal", goal.Outcome.String())
+	assert.True(t, goal.OnTarget())
+	assert.Equal(t, fifa.HomeSide, goal.Side)
+	assert.InDelta(t, 94.5, goal.X, 1e-9)
+	assert.InDelta(t, 34, goal.Y, 1e-9)
+	assert.InDelta(t, 10.5, goal.Distance, 1e-9)
+	assert.InDelta(t, 38.43, goal.Angle, 0.01)
+	assert.True(t, goal.HasPlacement)
+	assert.Equal(t, "high left", goal.Placement())
+
+	miss := shots[1]
+	assert.Equal(t, fifa.OutcomeOffTarget, miss.Outcome)
+	assert.Equal(t, fifa.AwaySide, miss.Side)
+	assert.InDelta(t, 84, miss.X, 1e-9, "away shots in the first half should be flipped")
+	assert.InDelta(t, 40.8, miss.Y, 1e-9)
+	assert.False(t, miss.HasPlacement)
+	assert.Equal(t, "", miss.Placement())
+
+	assert.Equal(t, fifa.OutcomeBlocked, shots[2].Outcome)
+	assert.False(t, shots[2].OnTarget())
+
+	saved := shots[3]
+	assert.Equal(t, fifa.OutcomeSaved, saved.Outcome)
+	assert.InDelta(t, 94.5, saved.X, 1e-9, "home shots in the second half should be flipped")
+	assert.InDelta(t, 47.6, saved.Y, 1e-9)
+	assert.Equal(t, "low centre", saved.Placement())
+
+	woodwork := shots[4]
+	assert.Equal(t, fifa.OutcomeWoodwork, woodwork.Outcome)
+	assert.InDelta(t, 89.25, woodwork.X, 1e-9)
+
+	withShootout := fifa.ExtractShots(shotsFixture(t), nil, nil, &fifa.ShotOptions{IncludeShootout: true})
+	if ok := assert.Len(t, withShootout, 6); ok {
+		last := withShootout[5]
+		assert.True(t, last.Penalty)
+		assert.InDelta(t, 93.45, last.X, 1e-9, "shoot-out kicks should be aimed at the nearer goal")
+	}
+}
+
+func TestExtractShotsOptions(t *testing.T) {
+	t.Parallel()
+	events := shotsFixture(t)
+	home, away := &fifa.TeamResponse{Id: "ARG"}, &fifa.TeamResponse{Id: "FRA"}
+
+	fixed := fifa.ExtractShots(events, home, away, &fifa.ShotOptions{Direction: fifa.DirectionHomeLeftFirst})
+	if ok := assert.Len(t, fixed, 5); !ok {
+		t.FailNow()
+	}
+	assert.InDelta(t, 10.5, fixed[0].X, 1e-9, "home should attack towards raw X = 0 in the first half")
+	assert.InDelta(t, 21, fixed[1].X, 1e-9)
+
+	normalized := fifa.ExtractShots(events, home, away, &fifa.ShotOptions{Direction: fifa.DirectionNormalized})
+	assert.InDelta(t, 10.5, normalized[3].X, 1e-9)
+
+	scaled := fifa.ExtractShots(events, home, away, &fifa.ShotOptions{PitchLength: 100, PitchWidth: 60, Scale: 1, GoalMouthScale: 10})
+	assert.InDelta(t, 9000, scaled[0].X, 1e-9)
+	assert.Equal(t, "wide left", scaled[3].Placement(), "goal-mouth coordinates should follow GoalMouthScale")
+}
+
+func TestExtractShotsInfersDirectionFromPairedPeriod(t *testing.T) {
+	t.Parallel()
+	events := &fifa.GetMatchEventsResponse{Events: []fifa.EventResponse{
+		shotEvent(t, fifa.GoalAttempt, fifa.FIRST, "10'", "H", 30, 50, 0, 0),
+		shotEvent(t, fifa.GoalAttempt, fifa.FIRST, "20'", "H", 20, 50, 0, 0),
+		shotEvent(t, fifa.PenaltyMissed, fifa.SECOND, "60'", "H", 45, 50, 0, 0),
+	}}
+	shots := fifa.ExtractShots(events, &fifa.TeamResponse{Id: "H"}, &fifa.TeamResponse{Id: "A"}, nil)
+	if ok := assert.Len(t, shots, 3); !ok {
+		t.FailNow()
+	}
+	assert.InDelta(t, 73.5, shots[0].X, 1e-9)
+	assert.InDelta(t, 47.25, shots[2].X, 1e-9, "penalties should take the direction of the paired period")
+}
+
+func TestGetMatchShots(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{responses: map[string]stubResponse{
+		"/live/football/17/s/g/m": {Body: `{"IdMatch":"m","HomeTeam":{"TeamId":"ARG"},"AwayTeam":{"TeamId":"FRA"}}`},
+		"/timelines/17/s/g/m": {Body: `{"IdMatch":"m","Event":[
+			{"Type":12,"Period":3,"MatchMinute":"5'","IdTeam":"FRA","PositionX":80,"PositionY":50},
+			{"Type":0,"Period":3,"MatchMinute":"9'","IdTeam":"ARG","PositionX":10,"PositionY":50,"HomeGoals":1}
+		]}`},
+	}}
+	client := &fifa.Client{Client: stub}
+	shots, err := client.GetMatchShots(&fifa.MatchRef{CompetitionId: "17", SeasonId: "s", StageId: "g", MatchId: "m"}, nil)
+	if ok := assert.Nil(t, err, "expected no error with GetMatchShots, got: %s", err); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Len(t, shots, 2); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, fifa.AwaySide, shots[0].Side)
+	assert.InDelta(t, 84, shots[0].X, 1e-9)
+	assert.InDelta(t, 94.5, shots[1].X, 1e-9)
+
+	_, err = client.GetMatchShots(&fifa.MatchRef{MatchId: "m"}, nil)
+	assert.NotNil(t, err)
+}
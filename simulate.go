@@ -0,0 +1,581 @@
+package go_fifa
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const defaultSimulationIterations = 10000
+
+// MatchModel predicts the score probabilities of a match. PoissonModel
+// implements it.
+type MatchModel interface {
+	PredictMatch(m *MatchResponse) Prediction
+}
+
+type SimulationOptions struct {
+	// Iterations defaults to 10000.
+	Iterations int
+	Seed       int64
+	// Rules defaults to WorldCupStandingsRules.
+	Rules StandingsRules
+	// BestThirds is the number of teams at a group position shared by
+	// several knockout slots, such as the third placed teams in "3A/B/C",
+	// that go through. Defaults to the number of such slots in the bracket.
+	BestThirds int
+	// ThirdPlaceAllocation assigns the qualified teams to those slots, as
+	// in a competition's allocation table. It is keyed by the letters of the
+	// groups they come from, sorted and joined, such as "ABCD", and maps each
+	// slot's placeholder to the letter of the group whose team takes it.
+	// Without it, teams are matched to slots listing their group, the best
+	// ranked first.
+	ThirdPlaceAllocation map[string]map[string]string
+}
+
+type TeamSimulation struct {
+	TeamId  string
+	GroupId string
+	// GroupPositions is the probability of each final group position.
+	GroupPositions map[int]float64
+	// Reached is the probability of playing in each knockout round, keyed by
+	// round name.
+	Reached map[string]float64
+	Win     float64
+}
+
+type SimulationResult struct {
+	Iterations int
+	// Rounds are the knockout rounds in order, leaving out the third place
+	// play-off.
+	Rounds []string
+	// Teams are sorted by their probability of winning.
+	Teams []TeamSimulation
+}
+
+func (r *SimulationResult) Team(teamId string) *TeamSimulation {
+	for i := range r.Teams {
+		if r.Teams[i].TeamId == teamId {
+			return &r.Teams[i]
+		}
+	}
+	return nil
+}
+
+// groupPlaceholderPattern matches knockout placeholders referring to group
+// positions, such as "1A", "2B" or "3A/B/C" for one of the best thirds.
+var groupPlaceholderPattern = regexp.MustCompile(`^(\d+)\s*([A-Z](?:/?[A-Z])*)$`)
+
+type simulator struct {
+	model      MatchModel
+	rules      StandingsRules
+	bestThirds int
+	allocation map[string]map[string]string
+	rnd        *rand.Rand
+	cache      map[string]Prediction
+	teams      map[string]TeamResponse
+	letters    map[string]string
+	groupSlots []groupSlot
+}
+
+// groupSlot is a knockout slot shared by teams at a position in several
+// groups, such as "3A/B/C".
+type groupSlot struct {
+	key         slotKey
+	position    int
+	placeholder string
+	letters     string
+}
+
+type slotKey struct {
+	tie  *BracketTie
+	home bool
+}
+
+// Simulate plays out the remaining group and knockout matches of a season
+// with the given model and reports how often each team reaches each round.
+// Group tables are decided with the tie-breaker rules and knockout slots are
+// filled from placeholders such as "1A", "3A/B/C" and "W49", or from the
+// feeding ties of the bracket. Slots shared by several groups go to the best
+// ranked teams at that position across groups, and an error is returned if
+// they cannot all be filled. Drawn knockout ties go to extra time, with a
+// third of the expected goals, and then to penalties decided by a coin toss.
+// Results are deterministic for a given seed.
+func Simulate(matches []MatchResponse, stages []StageResponse, model MatchModel, opts *SimulationOptions) (*SimulationResult, error) {
+	if model == nil {
+		return nil, errors.New("model is required but was not provided")
+	}
+	o := SimulationOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Iterations <= 0 {
+		o.Iterations = defaultSimulationIterations
+	}
+	if o.Rules.TieBreakers == nil {
+		o.Rules = WorldCupStandingsRules
+	}
+	s := &simulator{
+		model:      model,
+		rules:      o.Rules,
+		bestThirds: o.BestThirds,
+		allocation: o.ThirdPlaceAllocation,
+		rnd:        rand.New(rand.NewSource(o.Seed)),
+		cache:      map[string]Prediction{},
+		teams:      map[string]TeamResponse{},
+		letters:    map[string]string{},
+	}
+
+	var groupMatches []MatchResponse
+	for _, m := range matches {
+		for _, team := range []TeamResponse{m.HomeTeam, m.AwayTeam} {
+			if team.Id != "" {
+				s.teams[team.Id] = TeamResponse{Id: team.Id, CountryId: team.CountryId, Name: team.Name}
+			}
+		}
+		if m.GroupId == "" {
+			continue
+		}
+		groupMatches = append(groupMatches, m)
+		if len(m.GroupName) > 0 {
+			fields := strings.Fields(m.GroupName[0].Description)
+			if len(fields) > 0 {
+				s.letters[strings.ToUpper(fields[len(fields)-1])] = m.GroupId
+			}
+		}
+	}
+	sort.SliceStable(groupMatches, func(i, j int) bool {
+		return groupMatches[i].Date.Before(groupMatches[j].Date)
+	})
+	bracket := NewBracket(matches, stages)
+
+	result := &SimulationResult{Iterations: o.Iterations}
+	for _, r := range bracket.Rounds {
+		if len(r.Ties) > 0 && !r.Ties[0].ThirdPlace {
+			result.Rounds = append(result.Rounds, r.Name)
+		}
+		for _, t := range r.Ties {
+			s.addGroupSlot(t, true, t.HomeTeamId, t.HomePlaceholder)
+			s.addGroupSlot(t, false, t.AwayTeamId, t.AwayPlaceholder)
+		}
+	}
+	stats := map[string]*TeamSimulation{}
+	stat := func(teamId string) *TeamSimulation {
+		t, ok := stats[teamId]
+		if !ok {
+			t = &TeamSimulation{TeamId: teamId, GroupPositions: map[int]float64{}, Reached: map[string]float64{}}
+			stats[teamId] = t
+		}
+		return t
+	}
+
+	scenario := make([]MatchResponse, len(groupMatches))
+	for it := 0; it < o.Iterations; it++ {
+		copy(scenario, groupMatches)
+		for i := range scenario {
+			m := &scenario[i]
+			if m.HomeTeam.Id == "" || m.AwayTeam.Id == "" {
+				continue
+			}
+			switch m.Status {
+			case TO_BE_PLAYED, LIVE:
+				m.HomeTeam.Score, m.AwayTeam.Score = s.play(m, remainingFraction(m))
+				m.Status = PLAYED
+			}
+		}
+		// Drawing of lots must not favour the same team in every iteration.
+		rules := s.rules
+		rules.LotsSeed = s.rnd.Int63()
+		positions := map[string]map[int]string{}
+		standings := ComputeStandings(scenario, rules)
+		for _, r := range standings {
+			t := stat(r.Team.Id)
+			t.GroupId = r.GroupId
+			t.GroupPositions[r.Position]++
+			if positions[r.GroupId] == nil {
+				positions[r.GroupId] = map[int]string{}
+			}
+			positions[r.GroupId][r.Position] = r.Team.Id
+		}
+		assigned, err := s.allocateGroupSlots(standings, rules)
+		if err != nil {
+			return nil, err
+		}
+		ko := &knockoutRun{
+			sim:       s,
+			positions: positions,
+			assigned:  assigned,
+			winners:   map[*BracketTie]string{},
+			losers:    map[*BracketTie]string{},
+		}
+		for _, round := range bracket.Rounds {
+			for _, t := range round.Ties {
+				home, away := ko.slot(t, true), ko.slot(t, false)
+				if !t.ThirdPlace {
+					for _, id := range []string{home, away} {
+						if id != "" {
+							stat(id).Reached[round.Name]++
+						}
+					}
+				}
+				winner := ko.play(t, home, away)
+				ko.winners[t] = winner
+				if winner == home {
+					ko.losers[t] = away
+				} else {
+					ko.losers[t] = home
+				}
+			}
+		}
+		if final := bracket.Final(); final != nil && ko.winners[final] != "" {
+			stat(ko.winners[final]).Win++
+		}
+	}
+
+	n := float64(o.Iterations)
+	for _, t := range stats {
+		for k := range t.GroupPositions {
+			t.GroupPositions[k] /= n
+		}
+		for k := range t.Reached {
+			t.Reached[k] /= n
+		}
+		t.Win /= n
+		result.Teams = append(result.Teams, *t)
+	}
+	sort.Slice(result.Teams, func(i, j int) bool {
+		if result.Teams[i].Win != result.Teams[j].Win {
+			return result.Teams[i].Win > result.Teams[j].Win
+		}
+		return result.Teams[i].TeamId < result.Teams[j].TeamId
+	})
+	return result, nil
+}
+
+// remainingFraction is the share of a match still to be played, judged from
+// the match time of live matches.
+func remainingFraction(m *MatchResponse) float64 {
+	if m.Status != LIVE {
+		return 1
+	}
+	minute, err := ParseMatchMinute(m.MatchTime)
+	if err != nil {
+		return 1
+	}
+	played := float64(minute.Minute + minute.Added)
+	if played >= 90 {
+		return 0
+	}
+	return (90 - played) / 90
+}
+
+func (s *simulator) predict(m *MatchResponse) Prediction {
+	key := m.HomeTeam.Id + "|" + m.AwayTeam.Id + "|" + m.Stadium.CountryId
+	p, ok := s.cache[key]
+	if !ok {
+		p = s.model.PredictMatch(m)
+		s.cache[key] = p
+	}
+	return p
+}
+
+// play adds simulated goals to the current score of a match, scaling the
+// expected goals by the share of the match left.
+func (s *simulator) play(m *MatchResponse, fraction float64) (int, int) {
+	home, away := m.HomeTeam.Score, m.AwayTeam.Score
+	if fraction <= 0 {
+		return home, away
+	}
+	p := s.predict(m)
+	if fraction < 1 {
+		p = NewPrediction(p.HomeTeamId, p.AwayTeamId, p.HomeExpectedGoals*fraction, p.AwayExpectedGoals*fraction, len(p.Scores)-1)
+	}
+	h, a := s.sample(&p)
+	return home + h, away + a
+}
+
+func (s *simulator) sample(p *Prediction) (int, int) {
+	x := s.rnd.Float64()
+	for h := range p.Scores {
+		for a, v := range p.Scores[h] {
+			x -= v
+			if x < 0 {
+				return h, a
+			}
+		}
+	}
+	return 0, 0
+}
+
+// addGroupSlot records a slot filled from several groups, such as "3A/B/C".
+func (s *simulator) addGroupSlot(t *BracketTie, home bool, teamId string, placeholder string) {
+	if teamId != "" {
+		return
+	}
+	normalized := strings.ToUpper(strings.TrimSpace(placeholder))
+	parts := groupPlaceholderPattern.FindStringSubmatch(normalized)
+	if parts == nil {
+		return
+	}
+	letters := strings.ReplaceAll(parts[2], "/", "")
+	if len(letters) < 2 {
+		return
+	}
+	pos, _ := strconv.Atoi(parts[1])
+	s.groupSlots = append(s.groupSlots, groupSlot{
+		key:         slotKey{tie: t, home: home},
+		position:    pos,
+		placeholder: normalized,
+		letters:     letters,
+	})
+}
+
+// allocateGroupSlots ranks the teams at each position shared by several
+// slots across groups, keeps the best ones that go through and assigns them
+// to the slots, from the allocation table if there is one.
+func (s *simulator) allocateGroupSlots(standings []StandingsResult, rules StandingsRules) (map[slotKey]string, error) {
+	assigned := map[slotKey]string{}
+	if len(s.groupSlots) == 0 {
+		return assigned, nil
+	}
+	groupLetters := map[string]string{}
+	for letter, groupId := range s.letters {
+		groupLetters[groupId] = letter
+	}
+	var positions []int
+	slotsAt := map[int][]groupSlot{}
+	for _, slot := range s.groupSlots {
+		if _, ok := slotsAt[slot.position]; !ok {
+			positions = append(positions, slot.position)
+		}
+		slotsAt[slot.position] = append(slotsAt[slot.position], slot)
+	}
+	for _, pos := range positions {
+		slots := slotsAt[pos]
+		var rows []StandingsResult
+		for _, r := range standings {
+			if r.Position == pos {
+				rows = append(rows, r)
+			}
+		}
+		qualified := RankAcrossGroups(rows, rules)
+		n := s.bestThirds
+		if n <= 0 {
+			n = len(slots)
+		}
+		if len(qualified) > n {
+			qualified = qualified[:n]
+		}
+		var teams []int
+		var err error
+		if s.allocation != nil {
+			teams, err = allocateFromTable(slots, qualified, groupLetters, s.allocation)
+		} else {
+			teams, err = matchGroupSlots(slots, qualified, groupLetters)
+		}
+		if err != nil {
+			return nil, err
+		}
+		for i, slot := range slots {
+			assigned[slot.key] = qualified[teams[i]].Team.Id
+		}
+	}
+	return assigned, nil
+}
+
+// allocateFromTable returns, for every slot, the index of the qualified team
+// the allocation table assigns to it.
+func allocateFromTable(slots []groupSlot, qualified []StandingsResult, groupLetters map[string]string, table map[string]map[string]string) ([]int, error) {
+	byLetter := map[string]int{}
+	var letters []string
+	for i, r := range qualified {
+		letter := groupLetters[r.GroupId]
+		byLetter[letter] = i
+		letters = append(letters, letter)
+	}
+	sort.Strings(letters)
+	key := strings.Join(letters, "")
+	row, ok := table[key]
+	if !ok {
+		return nil, fmt.Errorf("no allocation for teams from groups %s", key)
+	}
+	teams := make([]int, len(slots))
+	for i, slot := range slots {
+		letter := strings.ToUpper(row[slot.placeholder])
+		team, ok := byLetter[letter]
+		if !ok {
+			return nil, fmt.Errorf("no qualified team allocated to %s for groups %s", slot.placeholder, key)
+		}
+		teams[i] = team
+	}
+	return teams, nil
+}
+
+// matchGroupSlots assigns every slot a qualified team from one of the groups
+// it lists, returning the index of each slot's team. Slots are filled in
+// bracket order with the best ranked team available, moving earlier teams to
+// other slots when that is the only way to fill them all.
+func matchGroupSlots(slots []groupSlot, qualified []StandingsResult, groupLetters map[string]string) ([]int, error) {
+	teams := make([]int, len(slots))
+	owner := make([]int, len(qualified))
+	for i := range owner {
+		owner[i] = -1
+	}
+	var assign func(slot int, seen []bool) bool
+	assign = func(slot int, seen []bool) bool {
+		for team, r := range qualified {
+			letter := groupLetters[r.GroupId]
+			if seen[team] || letter == "" || !strings.Contains(slots[slot].letters, letter) {
+				continue
+			}
+			seen[team] = true
+			if owner[team] < 0 || assign(owner[team], seen) {
+				owner[team] = slot
+				teams[slot] = team
+				return true
+			}
+		}
+		return false
+	}
+	for i := range slots {
+		if !assign(i, make([]bool, len(qualified))) {
+			return nil, fmt.Errorf("no qualified team can fill %s", slots[i].placeholder)
+		}
+	}
+	return teams, nil
+}
+
+type knockoutRun struct {
+	sim       *simulator
+	positions map[string]map[int]string
+	assigned  map[slotKey]string
+	winners   map[*BracketTie]string
+	losers    map[*BracketTie]string
+}
+
+func (k *knockoutRun) slot(t *BracketTie, home bool) string {
+	teamId, placeholder, feeder := t.AwayTeamId, t.AwayPlaceholder, t.AwayFeeder
+	if home {
+		teamId, placeholder, feeder = t.HomeTeamId, t.HomePlaceholder, t.HomeFeeder
+	}
+	if teamId != "" {
+		return teamId
+	}
+	if id, ok := k.assigned[slotKey{tie: t, home: home}]; ok {
+		return id
+	}
+	if id, ok := k.fromGroup(placeholder); ok {
+		return id
+	}
+	if n, ok := placeholderMatchNumber(placeholder); ok {
+		loser := strings.HasPrefix(strings.ToUpper(strings.TrimSpace(placeholder)), "L")
+		for f, w := range k.winners {
+			if f.hasMatchNumber(n) {
+				if loser {
+					return k.losers[f]
+				}
+				return w
+			}
+		}
+	}
+	if feeder != nil {
+		if t.ThirdPlace {
+			return k.losers[feeder]
+		}
+		return k.winners[feeder]
+	}
+	return ""
+}
+
+// fromGroup resolves a placeholder for a position in a single group, such as
+// "1A".
+func (k *knockoutRun) fromGroup(placeholder string) (string, bool) {
+	parts := groupPlaceholderPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(placeholder)))
+	if parts == nil || len(parts[2]) != 1 {
+		return "", false
+	}
+	groupId, ok := k.sim.letters[parts[2]]
+	if !ok {
+		return "", false
+	}
+	pos, _ := strconv.Atoi(parts[1])
+	return k.positions[groupId][pos], true
+}
+
+// play returns the winner of a tie, simulating the legs still to be played.
+// A team facing an empty slot goes through.
+func (k *knockoutRun) play(t *BracketTie, home string, away string) string {
+	if t.Decided() {
+		return t.WinnerId
+	}
+	if home == "" || away == "" {
+		if home != "" {
+			return home
+		}
+		return away
+	}
+	s := k.sim
+	var homeGoals, awayGoals int
+	var last MatchResponse
+	for i, leg := range t.Legs {
+		legHome, legAway := home, away
+		if i%2 == 1 {
+			legHome, legAway = away, home
+		}
+		m := leg
+		if m.HomeTeam.Id != legHome || m.AwayTeam.Id != legAway {
+			m.HomeTeam = s.teams[legHome]
+			m.AwayTeam = s.teams[legAway]
+			m.Status = TO_BE_PLAYED
+		}
+		h, a := m.HomeTeam.Score, m.AwayTeam.Score
+		if m.Status != PLAYED {
+			h, a = s.play(&m, remainingFraction(&m))
+		}
+		if legHome == home {
+			homeGoals, awayGoals = homeGoals+h, awayGoals+a
+		} else {
+			homeGoals, awayGoals = homeGoals+a, awayGoals+h
+		}
+		last = m
+	}
+	if homeGoals == awayGoals {
+		p := s.predict(&last)
+		extra := NewPrediction(p.HomeTeamId, p.AwayTeamId, p.HomeExpectedGoals/3, p.AwayExpectedGoals/3, len(p.Scores)-1)
+		h, a := s.sample(&extra)
+		if last.HomeTeam.Id == home {
+			homeGoals, awayGoals = homeGoals+h, awayGoals+a
+		} else {
+			homeGoals, awayGoals = homeGoals+a, awayGoals+h
+		}
+	}
+	switch {
+	case homeGoals > awayGoals:
+		return home
+	case awayGoals > homeGoals:
+		return away
+	case s.rnd.Intn(2) == 0:
+		return home
+	}
+	return away
+}
+
+// SimulateSeason simulates the rest of a season from its stages and calendar.
+func (c *Client) SimulateSeason(season *GetSeasonMatchesOptions, model MatchModel, opts *SimulationOptions) (*SimulationResult, error) {
+	stages, err := c.GetStages(&GetStagesOptions{
+		CompetitionId: season.CompetitionId,
+		SeasonId:      season.SeasonId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	matches, err := c.GetSeasonMatches(season)
+	if err != nil {
+		return nil, err
+	}
+	return Simulate(matches, stages, model, opts)
+}
@@ -0,0 +1,160 @@
+package go_fifa_test
+
+import (
+	"testing"
+	"time"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+type strengthModel map[string]float64
+
+func (s strengthModel) PredictMatch(m *fifa.MatchResponse) fifa.Prediction {
+	strength := func(id string) float64 {
+		if v, ok := s[id]; ok {
+			return v
+		}
+		return 1
+	}
+	return fifa.NewPrediction(m.HomeTeam.Id, m.AwayTeam.Id, strength(m.HomeTeam.Id), strength(m.AwayTeam.Id), 8)
+}
+
+func inGroup(group string) matchOption {
+	return func(m *fifa.MatchResponse) {
+		m.GroupId = group
+		m.StageId = "group"
+		m.GroupName = []fifa.DefaultDescriptionResponse{{Locale: "en-GB", Description: "Group " + group}}
+	}
+}
+
+func upcomingKnockout(id string, number int, stage string, a string, b string) fifa.MatchResponse {
+	m := testMatch(id, "", 0, "", 0, numbered(number), inStage(stage), playedOnDay(time.December, 20+number), toBePlayed)
+	m.PlaceHolderA = a
+	m.PlaceHolderB = b
+	return m
+}
+
+func simulationSeason() ([]fifa.MatchResponse, []fifa.StageResponse) {
+	matches := []fifa.MatchResponse{
+		testMatch("a1", "A1", 2, "A2", 0, inGroup("A")),
+		testMatch("a2", "A2", 1, "A3", 0, inGroup("A")),
+		testMatch("a3", "A1", 1, "A3", 0, inGroup("A")),
+		testMatch("b1", "B1", 0, "B2", 0, inGroup("B"), toBePlayed),
+		testMatch("b2", "B2", 0, "B3", 0, inGroup("B"), toBePlayed),
+		testMatch("b3", "B1", 0, "B3", 0, inGroup("B"), toBePlayed),
+		upcomingKnockout("s1", 7, "sf", "1A", "2B"),
+		upcomingKnockout("s2", 8, "sf", "1B", "2A"),
+		upcomingKnockout("f", 9, "final", "W7", "W8"),
+	}
+	stages := []fifa.StageResponse{
+		bracketStage("group", 1, "First Stage"),
+		bracketStage("sf", 2, "Semi-final"),
+		bracketStage("final", 3, "Final"),
+	}
+	return matches, stages
+}
+
+func TestSimulate(t *testing.T) {
+	t.Parallel()
+	matches, stages := simulationSeason()
+	model := strengthModel{"B1": 3, "A1": 1.5}
+	opts := &fifa.SimulationOptions{Iterations: 2000, Seed: 7}
+	result, err := fifa.Simulate(matches, stages, model, opts)
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, []string{"Semi-final", "Final"}, result.Rounds)
+	assert.Equal(t, "B1", result.Teams[0].TeamId)
+
+	var semis, finals, wins float64
+	for _, team := range result.Teams {
+		semis += team.Reached["Semi-final"]
+		finals += team.Reached["Final"]
+		wins += team.Win
+	}
+	assert.InDelta(t, 4, semis, 1e-9)
+	assert.InDelta(t, 2, finals, 1e-9)
+	assert.InDelta(t, 1, wins, 1e-9)
+
+	a1 := result.Team("A1")
+	if ok := assert.NotNil(t, a1); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, 1.0, a1.GroupPositions[1], "group A is finished")
+	assert.Equal(t, 1.0, a1.Reached["Semi-final"])
+	assert.Equal(t, 0.0, result.Team("A3").Reached["Semi-final"])
+	assert.Greater(t, result.Team("B1").GroupPositions[1], 0.8)
+
+	again, err := fifa.Simulate(matches, stages, model, opts)
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, result, again, "a seed should make the simulation reproducible")
+}
+
+func TestSimulateRequiresModel(t *testing.T) {
+	t.Parallel()
+	matches, stages := simulationSeason()
+	_, err := fifa.Simulate(matches, stages, nil, nil)
+	assert.NotNil(t, err)
+}
+
+// bestThirdsSeason has three finished groups whose thirds rank B3, C3, A3,
+// with two of them going through to slots a greedy allocation would fill
+// with A3.
+func bestThirdsSeason() ([]fifa.MatchResponse, []fifa.StageResponse) {
+	matches := []fifa.MatchResponse{
+		testMatch("a1", "A1", 1, "A2", 0, inGroup("A")),
+		testMatch("a2", "A1", 1, "A3", 0, inGroup("A")),
+		testMatch("a3", "A2", 1, "A3", 0, inGroup("A")),
+		testMatch("b1", "B1", 1, "B2", 0, inGroup("B")),
+		testMatch("b2", "B1", 2, "B3", 0, inGroup("B")),
+		testMatch("b3", "B2", 3, "B3", 3, inGroup("B")),
+		testMatch("c1", "C1", 1, "C2", 0, inGroup("C")),
+		testMatch("c2", "C1", 1, "C3", 0, inGroup("C")),
+		testMatch("c3", "C2", 2, "C3", 1, inGroup("C")),
+		upcomingKnockout("q1", 1, "qf", "1A", "3B/C"),
+		upcomingKnockout("q2", 2, "qf", "1B", "3A/B"),
+		upcomingKnockout("q3", 3, "qf", "1C", "2A"),
+		upcomingKnockout("q4", 4, "qf", "2B", "2C"),
+		upcomingKnockout("s1", 5, "sf", "W1", "W2"),
+		upcomingKnockout("s2", 6, "sf", "W3", "W4"),
+		upcomingKnockout("f", 7, "final", "W5", "W6"),
+	}
+	stages := []fifa.StageResponse{
+		bracketStage("group", 1, "First Stage"),
+		bracketStage("qf", 2, "Quarter-final"),
+		bracketStage("sf", 3, "Semi-final"),
+		bracketStage("final", 4, "Final"),
+	}
+	return matches, stages
+}
+
+func TestSimulateBestThirds(t *testing.T) {
+	t.Parallel()
+	matches, stages := bestThirdsSeason()
+	model := strengthModel{"A1": 20, "B1": 0.01, "B3": 3, "C3": 3}
+	result, err := fifa.Simulate(matches, stages, model, &fifa.SimulationOptions{Iterations: 500, Seed: 3})
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, 0.0, result.Team("A3").Reached["Quarter-final"], "the worst third should not go through")
+	assert.Equal(t, 1.0, result.Team("B3").Reached["Quarter-final"])
+	assert.Equal(t, 1.0, result.Team("C3").Reached["Quarter-final"])
+	assert.Greater(t, result.Team("B3").Reached["Semi-final"], 0.9, "B3 should be moved to the slot against 1B")
+	assert.Less(t, result.Team("C3").Reached["Semi-final"], 0.1, "C3 should face 1A")
+
+	table := map[string]map[string]string{"BC": {"3B/C": "B", "3A/B": "C"}}
+	result, err = fifa.Simulate(matches, stages, model, &fifa.SimulationOptions{Iterations: 500, Seed: 3, ThirdPlaceAllocation: table})
+	if ok := assert.Nil(t, err); !ok {
+		t.FailNow()
+	}
+	assert.Less(t, result.Team("B3").Reached["Semi-final"], 0.1, "the allocation table should put B3 against 1A")
+	assert.Greater(t, result.Team("C3").Reached["Semi-final"], 0.9)
+
+	_, err = fifa.Simulate(matches, stages, model, &fifa.SimulationOptions{Iterations: 10, BestThirds: 1})
+	assert.NotNil(t, err, "two slots cannot be filled by a single qualified third")
+	_, err = fifa.Simulate(matches, stages, model, &fifa.SimulationOptions{Iterations: 10, ThirdPlaceAllocation: map[string]map[string]string{}})
+	assert.NotNil(t, err, "a combination missing from the allocation table should fail")
+}
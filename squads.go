@@ -0,0 +1,125 @@
+package go_fifa
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type SquadResponse struct {
+	TeamId        string                       `json:"IdTeam"`
+	CompetitionId string                       `json:"IdCompetition"`
+	SeasonId      string                       `json:"IdSeason"`
+	TeamName      []DefaultDescriptionResponse `json:"TeamName"`
+	Players       []SquadPlayerResponse        `json:"Players"`
+	Coaches       []CoachResponse              `json:"Officials"`
+	Properties    interface{}                  `json:"Properties"`
+	IsUpdateable  bool                         `json:"IsUpdateable"`
+}
+
+type SquadPlayerResponse struct {
+	Id                string                       `json:"IdPlayer"`
+	TeamId            string                       `json:"IdTeam"`
+	CountryId         string                       `json:"IdCountry"`
+	Name              []DefaultDescriptionResponse `json:"PlayerName"`
+	ShortName         []DefaultDescriptionResponse `json:"ShortName"`
+	ShirtNumber       int                          `json:"JerseyNum"`
+	Position          int                          `json:"Position"` // TODO: Enum
+	PositionLocalized []DefaultDescriptionResponse `json:"PositionLocalized"`
+	ClubId            string                       `json:"IdClub"`
+	Club              []DefaultDescriptionResponse `json:"ClubName"`
+	Birthdate         time.Time                    `json:"BirthDate"`
+	Height            float32                      `json:"Height"`
+	Weight            float32                      `json:"Weight"`
+}
+
+type GetSquadsResponse struct {
+	PaginatedResponse
+	Results []SquadResponse `json:"Results"`
+}
+
+type GetSquadOptions struct {
+	TeamId        string `url:"-"`
+	CompetitionId string `url:"IdCompetition,omitempty"`
+	SeasonId      string `url:"IdSeason"`
+}
+
+type GetSeasonSquadsOptions struct {
+	CompetitionId string
+	SeasonId      string
+}
+
+type SquadPlayerChange struct {
+	Before SquadPlayerResponse
+	After  SquadPlayerResponse
+}
+
+type SquadDiff struct {
+	Added   []SquadPlayerResponse
+	Removed []SquadPlayerResponse
+	Changed []SquadPlayerChange
+}
+
+func (d SquadDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+func (c *Client) GetSquad(opts *GetSquadOptions) (*SquadResponse, error) {
+	if opts.TeamId == "" {
+		return nil, errors.New("teamId is required but was not provided")
+	}
+	if opts.SeasonId == "" {
+		return nil, errors.New("seasonId is required but was not provided")
+	}
+	var squad SquadResponse
+	url := fmt.Sprintf("/teams/%s/squad", opts.TeamId)
+	_, err := c.get(url, &squad, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &squad, nil
+}
+
+func (c *Client) GetSeasonSquads(opts *GetSeasonSquadsOptions) ([]SquadResponse, error) {
+	if opts.CompetitionId == "" {
+		return nil, errors.New("competitionId is required but was not provided")
+	}
+	if opts.SeasonId == "" {
+		return nil, errors.New("seasonId is required but was not provided")
+	}
+	var respData GetSquadsResponse
+	url := fmt.Sprintf("/teams/squads/all/%s/%s", opts.CompetitionId, opts.SeasonId)
+	_, err := c.get(url, &respData, nil)
+	if err != nil {
+		return nil, err
+	}
+	return respData.Results, nil
+}
+
+// DiffSquads reports the players added to and removed from a squad between two
+// announcements, and players whose shirt number or position changed.
+func DiffSquads(before *SquadResponse, after *SquadResponse) SquadDiff {
+	var diff SquadDiff
+	previous := map[string]SquadPlayerResponse{}
+	for _, p := range before.Players {
+		previous[p.Id] = p
+	}
+	current := map[string]bool{}
+	for _, p := range after.Players {
+		current[p.Id] = true
+		old, ok := previous[p.Id]
+		if !ok {
+			diff.Added = append(diff.Added, p)
+			continue
+		}
+		if old.ShirtNumber != p.ShirtNumber || old.Position != p.Position {
+			diff.Changed = append(diff.Changed, SquadPlayerChange{Before: old, After: p})
+		}
+	}
+	for _, p := range before.Players {
+		if !current[p.Id] {
+			diff.Removed = append(diff.Removed, p)
+		}
+	}
+	return diff
+}
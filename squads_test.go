@@ -0,0 +1,55 @@
+package go_fifa_test
+
+import (
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSquad(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{responses: map[string]stubResponse{
+		"/teams/43922/squad": {Body: `{"IdTeam":"43922","IdSeason":"255711","Players":[
+			{"IdPlayer":"229397","PlayerName":[{"Locale":"en-GB","Description":"Lionel MESSI"}],"JerseyNum":10,"Position":3,"ClubName":[{"Locale":"en-GB","Description":"FC Barcelona"}]}
+		],"Officials":[{"IdCoach":"1","Role":0,"Name":[{"Locale":"en-GB","Description":"Jorge SAMPAOLI"}]}]}`},
+	}}
+	client := fifa.Client{Client: stub}
+	resp, err := client.GetSquad(&fifa.GetSquadOptions{TeamId: "43922", SeasonId: "255711"})
+	if ok := assert.Nil(t, err, "expected no error with GetSquad, got: %s", err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "IdSeason=255711", stub.requests[0].URL.RawQuery)
+	if ok := assert.Len(t, resp.Players, 1); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, 10, resp.Players[0].ShirtNumber)
+	assert.Equal(t, "FC Barcelona", resp.Players[0].Club[0].Description)
+	assert.Equal(t, "Jorge SAMPAOLI", resp.Coaches[0].Name[0].Description)
+}
+
+func TestDiffSquads(t *testing.T) {
+	t.Parallel()
+	before := &fifa.SquadResponse{Players: []fifa.SquadPlayerResponse{
+		{Id: "1", ShirtNumber: 1},
+		{Id: "2", ShirtNumber: 2},
+		{Id: "3", ShirtNumber: 3},
+	}}
+	after := &fifa.SquadResponse{Players: []fifa.SquadPlayerResponse{
+		{Id: "1", ShirtNumber: 1},
+		{Id: "3", ShirtNumber: 13},
+		{Id: "4", ShirtNumber: 4},
+	}}
+	diff := fifa.DiffSquads(before, after)
+	assert.False(t, diff.IsEmpty())
+	if ok := assert.Len(t, diff.Added, 1); ok {
+		assert.Equal(t, "4", diff.Added[0].Id)
+	}
+	if ok := assert.Len(t, diff.Removed, 1); ok {
+		assert.Equal(t, "2", diff.Removed[0].Id)
+	}
+	if ok := assert.Len(t, diff.Changed, 1); ok {
+		assert.Equal(t, 13, diff.Changed[0].After.ShirtNumber)
+	}
+	assert.True(t, fifa.DiffSquads(after, after).IsEmpty())
+}
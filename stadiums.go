@@ -0,0 +1,50 @@
+package go_fifa
+
+import (
+	"errors"
+	"fmt"
+)
+
+type GetStadiumOptions struct {
+	StadiumId string
+}
+
+type GetSeasonStadiumsOptions struct {
+	CompetitionId string
+	SeasonId      string
+}
+
+func (c *Client) GetStadium(opts *GetStadiumOptions) (*StadiumResponse, error) {
+	if opts.StadiumId == "" {
+		return nil, errors.New("stadiumId is required but was not provided")
+	}
+	var stadium StadiumResponse
+	url := fmt.Sprintf("/stadiums/%s", opts.StadiumId)
+	_, err := c.get(url, &stadium, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &stadium, nil
+}
+
+// GetSeasonStadiums returns the distinct stadiums used by the matches of a
+// season, in order of first use.
+func (c *Client) GetSeasonStadiums(opts *GetSeasonStadiumsOptions) ([]StadiumResponse, error) {
+	matches, err := c.GetSeasonMatches(&GetSeasonMatchesOptions{
+		CompetitionId: opts.CompetitionId,
+		SeasonId:      opts.SeasonId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	var stadiums []StadiumResponse
+	for _, m := range matches {
+		if m.Stadium.Id == "" || seen[m.Stadium.Id] {
+			continue
+		}
+		seen[m.Stadium.Id] = true
+		stadiums = append(stadiums, m.Stadium)
+	}
+	return stadiums, nil
+}
@@ -0,0 +1,44 @@
+package go_fifa_test
+
+import (
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetStadium(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: &stubHTTPClient{responses: map[string]stubResponse{
+		"/stadiums/5000378": {Body: `{"IdStadium":"5000378","Name":[{"Locale":"en-GB","Description":"Luzhniki Stadium"}],"Capacity":78011,"IdCountry":"RUS"}`},
+	}}}
+	resp, err := client.GetStadium(&fifa.GetStadiumOptions{StadiumId: "5000378"})
+	if ok := assert.Nil(t, err, "expected no error with GetStadium, got: %s", err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "Luzhniki Stadium", resp.Name[0].Description)
+	assert.Equal(t, 78011, resp.Capacity)
+}
+
+func TestGetSeasonStadiums(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: &stubHTTPClient{responses: map[string]stubResponse{
+		"/calendar/matches?Count=500&IdCompetition=17&IdSeason=255711": {Body: `{"ContinuationToken":"next","Results":[
+			{"IdMatch":"1","Stadium":{"IdStadium":"a"}},
+			{"IdMatch":"2","Stadium":{"IdStadium":"b"}}
+		]}`},
+		"/calendar/matches?ContinuationToken=next&Count=500&IdCompetition=17&IdSeason=255711": {Body: `{"Results":[
+			{"IdMatch":"3","Stadium":{"IdStadium":"a"}},
+			{"IdMatch":"4","Stadium":{"IdStadium":"c"}}
+		]}`},
+	}}}
+	resp, err := client.GetSeasonStadiums(&fifa.GetSeasonStadiumsOptions{CompetitionId: "17", SeasonId: "255711"})
+	if ok := assert.Nil(t, err, "expected no error with GetSeasonStadiums, got: %s", err); !ok {
+		t.FailNow()
+	}
+	var ids []string
+	for _, s := range resp {
+		ids = append(ids, s.Id)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+}
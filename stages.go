@@ -0,0 +1,204 @@
+package go_fifa
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+type StageType int
+
+const (
+	UnknownStage  StageType = 0
+	GroupStage    StageType = 1
+	KnockoutStage StageType = 2
+)
+
+func (t StageType) String() string {
+	switch t {
+	case GroupStage:
+		return "group"
+	case KnockoutStage:
+		return "knockout"
+	}
+	return "unknown"
+}
+
+type StageResponse struct {
+	Id            string                       `json:"IdStage"`
+	CompetitionId string                       `json:"IdCompetition"`
+	SeasonId      string                       `json:"IdSeason"`
+	Name          []DefaultDescriptionResponse `json:"Name"`
+	SequenceOrder int                          `json:"SequenceOrder"`
+	StageLevel    int                          `json:"StageLevel"`
+	StartDate     time.Time                    `json:"StartDate"`
+	EndDate       time.Time                    `json:"EndDate"`
+	Properties    interface{}                  `json:"Properties"`
+	IsUpdateable  bool                         `json:"IsUpdateable"`
+}
+
+type GroupResponse struct {
+	Id            string                       `json:"IdGroup"`
+	StageId       string                       `json:"IdStage"`
+	CompetitionId string                       `json:"IdCompetition"`
+	SeasonId      string                       `json:"IdSeason"`
+	Name          []DefaultDescriptionResponse `json:"Name"`
+	SequenceOrder int                          `json:"SequenceOrder"`
+	Properties    interface{}                  `json:"Properties"`
+	IsUpdateable  bool                         `json:"IsUpdateable"`
+}
+
+type GetStagesResponse struct {
+	PaginatedResponse
+	Results []StageResponse `json:"Results"`
+}
+
+type GetGroupsResponse struct {
+	PaginatedResponse
+	Results []GroupResponse `json:"Results"`
+}
+
+type GetStagesOptions struct {
+	CompetitionId     string `url:"IdCompetition"`
+	SeasonId          string `url:"IdSeason"`
+	Count             int    `url:"Count"`
+	ContinuationToken string `url:"ContinuationToken,omitempty"`
+}
+
+type GetStageOptions struct {
+	StageId string
+}
+
+type GetGroupsOptions struct {
+	CompetitionId     string `url:"IdCompetition"`
+	SeasonId          string `url:"IdSeason"`
+	StageId           string `url:"IdStage,omitempty"`
+	Count             int    `url:"Count"`
+	ContinuationToken string `url:"ContinuationToken,omitempty"`
+}
+
+type GetGroupOptions struct {
+	GroupId string
+}
+
+// Stage names are localized by the API's Accept-Language, so the keywords
+// cover English, Spanish, Portuguese, French, German and Italian names.
+var (
+	groupStageNames = []string{
+		"group", "first stage", "second stage", "league", "preliminary",
+		"grupo", "gruppe", "girone", "gironi", "ligue", "liga", "primera fase", "primeira fase", "premier tour", "vorrunde", "préliminaire", "preliminar",
+	}
+	knockoutStageNames = []string{
+		"round of", "final", "play-off", "playoff", "knockout", "third place",
+		"ronda de", "eliminatori", "eliminação", "élimination", "k.-o.", "ko-runde", "runde der", "tercer puesto", "terceiro lugar", "troisième place", "platz 3", "terzo posto",
+	}
+)
+
+// Type classifies the stage as a group or knockout stage based on its name.
+// Semi-finals and the like in every covered language contain "final".
+func (s StageResponse) Type() StageType {
+	return stageTypeFromNames(s.Name)
+}
+
+func stageTypeFromNames(names []DefaultDescriptionResponse) StageType {
+	for _, n := range names {
+		name := strings.ToLower(n.Description)
+		for _, k := range groupStageNames {
+			if strings.Contains(name, k) {
+				return GroupStage
+			}
+		}
+		for _, k := range knockoutStageNames {
+			if strings.Contains(name, k) {
+				return KnockoutStage
+			}
+		}
+	}
+	return UnknownStage
+}
+
+func (c *Client) GetStages(opts *GetStagesOptions) ([]StageResponse, error) {
+	if opts.CompetitionId == "" {
+		return nil, errors.New("competitionId is required but was not provided")
+	}
+	if opts.SeasonId == "" {
+		return nil, errors.New("seasonId is required but was not provided")
+	}
+	if opts.Count == 0 {
+		opts.Count = 500
+	}
+	pageOptions := *opts
+	var stages []StageResponse
+	err := getAllPages(&pageOptions.ContinuationToken, func() (string, int, error) {
+		var respData GetStagesResponse
+		if _, err := c.get("/stages", &respData, &pageOptions); err != nil {
+			return "", 0, err
+		}
+		stages = append(stages, respData.Results...)
+		return respData.ContinuationToken, len(respData.Results), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(stages, func(i, j int) bool {
+		return stages[i].SequenceOrder < stages[j].SequenceOrder
+	})
+	return stages, nil
+}
+
+func (c *Client) GetStage(opts *GetStageOptions) (*StageResponse, error) {
+	if opts.StageId == "" {
+		return nil, errors.New("stageId is required but was not provided")
+	}
+	var stage StageResponse
+	url := fmt.Sprintf("/stages/%s", opts.StageId)
+	_, err := c.get(url, &stage, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &stage, nil
+}
+
+func (c *Client) GetGroups(opts *GetGroupsOptions) ([]GroupResponse, error) {
+	if opts.CompetitionId == "" {
+		return nil, errors.New("competitionId is required but was not provided")
+	}
+	if opts.SeasonId == "" {
+		return nil, errors.New("seasonId is required but was not provided")
+	}
+	if opts.Count == 0 {
+		opts.Count = 500
+	}
+	pageOptions := *opts
+	var groups []GroupResponse
+	err := getAllPages(&pageOptions.ContinuationToken, func() (string, int, error) {
+		var respData GetGroupsResponse
+		if _, err := c.get("/groups", &respData, &pageOptions); err != nil {
+			return "", 0, err
+		}
+		groups = append(groups, respData.Results...)
+		return respData.ContinuationToken, len(respData.Results), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].SequenceOrder < groups[j].SequenceOrder
+	})
+	return groups, nil
+}
+
+func (c *Client) GetGroup(opts *GetGroupOptions) (*GroupResponse, error) {
+	if opts.GroupId == "" {
+		return nil, errors.New("groupId is required but was not provided")
+	}
+	var group GroupResponse
+	url := fmt.Sprintf("/groups/%s", opts.GroupId)
+	_, err := c.get(url, &group, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
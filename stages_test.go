@@ -0,0 +1,111 @@
+package go_fifa_test
+
+import (
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+const stagesFixture = `{"Results":[
+	{"IdStage":"285073","IdCompetition":"17","IdSeason":"255711","Name":[{"Locale":"en-GB","Description":"Final"}],"SequenceOrder":6,"StartDate":"2018-07-15T00:00:00Z"},
+	{"IdStage":"275073","IdCompetition":"17","IdSeason":"255711","Name":[{"Locale":"en-GB","Description":"First Stage"}],"SequenceOrder":1,"StartDate":"2018-06-14T00:00:00Z"},
+	{"IdStage":"275099","IdCompetition":"17","IdSeason":"255711","Name":[{"Locale":"en-GB","Description":"Round of 16"}],"SequenceOrder":2,"StartDate":"2018-06-30T00:00:00Z"}
+]}`
+
+func TestGetStages(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: &stubHTTPClient{responses: map[string]stubResponse{
+		"/stages": {Body: stagesFixture},
+	}}}
+	resp, err := client.GetStages(&fifa.GetStagesOptions{CompetitionId: "17", SeasonId: "255711"})
+	if ok := assert.Nil(t, err, "expected no error with GetStages, got: %s", err); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Len(t, resp, 3); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "275073", resp[0].Id, "stages should be sorted by SequenceOrder")
+	assert.Equal(t, fifa.GroupStage, resp[0].Type())
+	assert.Equal(t, fifa.KnockoutStage, resp[1].Type())
+	assert.Equal(t, fifa.KnockoutStage, resp[2].Type())
+}
+
+func TestGetStagesRequiresSeason(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{}
+	_, err := client.GetStages(&fifa.GetStagesOptions{CompetitionId: "17"})
+	assert.NotNil(t, err)
+}
+
+func TestGetGroup(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: &stubHTTPClient{responses: map[string]stubResponse{
+		"/groups/275077": {Body: `{"IdGroup":"275077","IdStage":"275073","Name":[{"Locale":"en-GB","Description":"Group A"}]}`},
+	}}}
+	resp, err := client.GetGroup(&fifa.GetGroupOptions{GroupId: "275077"})
+	if ok := assert.Nil(t, err, "expected no error with GetGroup, got: %s", err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "Group A", resp.Name[0].Description)
+	assert.Equal(t, "275073", resp.StageId)
+}
+
+func TestGetStagesPages(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: &stubHTTPClient{responses: map[string]stubResponse{
+		"/stages?Count=500&IdCompetition=17&IdSeason=255711": {Body: `{"ContinuationToken":"page2","Results":[
+			{"IdStage":"285073","Name":[{"Locale":"en-GB","Description":"Final"}],"SequenceOrder":6}
+		]}`},
+		"/stages?ContinuationToken=page2&Count=500&IdCompetition=17&IdSeason=255711": {Body: `{"Results":[
+			{"IdStage":"275073","Name":[{"Locale":"en-GB","Description":"First Stage"}],"SequenceOrder":1}
+		]}`},
+	}}}
+	resp, err := client.GetStages(&fifa.GetStagesOptions{CompetitionId: "17", SeasonId: "255711"})
+	if ok := assert.Nil(t, err, "expected no error with GetStages, got: %s", err); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Len(t, resp, 2, "stages from both pages should be returned"); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "275073", resp[0].Id)
+}
+
+func TestGetGroupsPages(t *testing.T) {
+	t.Parallel()
+	client := fifa.Client{Client: &stubHTTPClient{responses: map[string]stubResponse{
+		"/groups?Count=500&IdCompetition=17&IdSeason=255711": {Body: `{"ContinuationToken":"page2","Results":[
+			{"IdGroup":"b","Name":[{"Locale":"en-GB","Description":"Group B"}],"SequenceOrder":2}
+		]}`},
+		"/groups?ContinuationToken=page2&Count=500&IdCompetition=17&IdSeason=255711": {Body: `{"Results":[
+			{"IdGroup":"a","Name":[{"Locale":"en-GB","Description":"Group A"}],"SequenceOrder":1}
+		]}`},
+	}}}
+	resp, err := client.GetGroups(&fifa.GetGroupsOptions{CompetitionId: "17", SeasonId: "255711"})
+	if ok := assert.Nil(t, err, "expected no error with GetGroups, got: %s", err); !ok {
+		t.FailNow()
+	}
+	if ok := assert.Len(t, resp, 2, "groups from both pages should be returned"); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "a", resp[0].Id)
+}
+
+func TestStageTypeLocalized(t *testing.T) {
+	t.Parallel()
+	for name, want := range map[string]fifa.StageType{
+		"Fase de grupos":      fifa.GroupStage,
+		"Gruppenphase":        fifa.GroupStage,
+		"Phase de groupes":    fifa.GroupStage,
+		"Fase a gironi":       fifa.GroupStage,
+		"Octavos de final":    fifa.KnockoutStage,
+		"Huitièmes de finale": fifa.KnockoutStage,
+		"Achtelfinale":        fifa.KnockoutStage,
+		"Oitavas de final":    fifa.KnockoutStage,
+		"Spiel um Platz 3":    fifa.KnockoutStage,
+		"Tercer puesto":       fifa.KnockoutStage,
+	} {
+		stage := fifa.StageResponse{Name: []fifa.DefaultDescriptionResponse{{Locale: "xx", Description: name}}}
+		assert.Equal(t, want, stage.Type(), name)
+	}
+}
@@ -0,0 +1,432 @@
+package go_fifa
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+)
+
+type TieBreaker int
+
+const (
+	TieBreakPoints TieBreaker = iota
+	TieBreakGoalDifference
+	TieBreakGoalsScored
+	TieBreakWins
+	TieBreakHeadToHeadPoints
+	TieBreakHeadToHeadGoalDifference
+	TieBreakHeadToHeadGoalsScored
+	TieBreakFairPlay
+	TieBreakDrawingOfLots
+)
+
+func (t TieBreaker) isHeadToHead() bool {
+	return t == TieBreakHeadToHeadPoints || t == TieBreakHeadToHeadGoalDifference || t == TieBreakHeadToHeadGoalsScored
+}
+
+type StandingsRules struct {
+	PointsForWin  int
+	PointsForDraw int
+	PointsForLoss int
+	// TieBreakers are applied in order to teams level on all previous
+	// criteria. Head-to-head criteria only consider matches between those
+	// teams.
+	TieBreakers []TieBreaker
+	// ReapplyHeadToHead restarts the head-to-head criteria for a smaller
+	// group of teams still level after all of them were applied, as UEFA
+	// does.
+	ReapplyHeadToHead bool
+	// LotsSeed seeds the drawing of lots so tables are reproducible.
+	LotsSeed int64
+}
+
+var WorldCupStandingsRules = StandingsRules{
+	PointsForWin:  3,
+	PointsForDraw: 1,
+	TieBreakers: []TieBreaker{
+		TieBreakPoints,
+		TieBreakGoalDifference,
+		TieBreakGoalsScored,
+		TieBreakHeadToHeadPoints,
+		TieBreakHeadToHeadGoalDifference,
+		TieBreakHeadToHeadGoalsScored,
+		TieBreakFairPlay,
+		TieBreakDrawingOfLots,
+	},
+}
+
+var EuroStandingsRules = StandingsRules{
+	PointsForWin:  3,
+	PointsForDraw: 1,
+	TieBreakers: []TieBreaker{
+		TieBreakPoints,
+		TieBreakHeadToHeadPoints,
+		TieBreakHeadToHeadGoalDifference,
+		TieBreakHeadToHeadGoalsScored,
+		TieBreakGoalDifference,
+		TieBreakGoalsScored,
+		TieBreakWins,
+		TieBreakFairPlay,
+		TieBreakDrawingOfLots,
+	},
+	ReapplyHeadToHead: true,
+}
+
+// ComputeStandings builds group tables from the group matches given, counting
+// finished matches and live matches at their current score. Teams of matches
+// still to be played are listed with an empty record. Rows are returned by
+// group, then position; PreviousPosition holds the position without the live
+// matches. FairPlayCoefficient holds the fair play points, which are zero or
+// negative.
+func ComputeStandings(matches []MatchResponse, rules StandingsRules) []StandingsResult {
+	var counted, finished []MatchResponse
+	live := false
+	for _, m := range matches {
+		if m.GroupId == "" {
+			continue
+		}
+		switch m.Status {
+		case PLAYED:
+			counted = append(counted, m)
+			finished = append(finished, m)
+		case LIVE:
+			counted = append(counted, m)
+			live = true
+		}
+	}
+	rows := buildStandings(matches, counted, rules, nil)
+	previous := map[string]int{}
+	if live {
+		for _, r := range buildStandings(matches, finished, rules, nil) {
+			previous[r.GroupId+"/"+r.Team.Id] = r.Position
+		}
+	}
+	for i := range rows {
+		if live {
+			rows[i].PreviousPosition = previous[rows[i].GroupId+"/"+rows[i].Team.Id]
+		} else {
+			rows[i].PreviousPosition = rows[i].Position
+		}
+	}
+	return rows
+}
+
+// buildStandings ranks the teams of every group. When splits is not nil it
+// receives the tie-breaker that separated each team from the one above it.
+func buildStandings(all []MatchResponse, counted []MatchResponse, rules StandingsRules, splits map[string]TieBreaker) []StandingsResult {
+	groups := map[string]map[string]*StandingsResult{}
+	row := func(m *MatchResponse, team *TeamResponse) *StandingsResult {
+		g, ok := groups[m.GroupId]
+		if !ok {
+			g = map[string]*StandingsResult{}
+			groups[m.GroupId] = g
+		}
+		r, ok := g[team.Id]
+		if !ok {
+			r = &StandingsResult{
+				CompetitionId: m.CompetitionId,
+				SeasonId:      m.SeasonId,
+				GroupId:       m.GroupId,
+				Group:         m.GroupName,
+				Team: TeamResponse{
+					Id:            team.Id,
+					Name:          team.Name,
+					Abbreviation:  team.Abbreviation,
+					CountryId:     team.CountryId,
+					PictureURL:    team.PictureURL,
+					AssociationId: team.AssociationId,
+				},
+			}
+			g[team.Id] = r
+		}
+		return r
+	}
+	for i := range all {
+		m := &all[i]
+		if m.GroupId == "" || m.HomeTeam.Id == "" || m.AwayTeam.Id == "" {
+			continue
+		}
+		row(m, &m.HomeTeam)
+		row(m, &m.AwayTeam)
+	}
+	for i := range counted {
+		m := &counted[i]
+		if m.HomeTeam.Id == "" || m.AwayTeam.Id == "" {
+			continue
+		}
+		home := row(m, &m.HomeTeam)
+		away := row(m, &m.AwayTeam)
+		home.addResult(m, m.HomeTeam.Score, m.AwayTeam.Score, true, rules)
+		away.addResult(m, m.AwayTeam.Score, m.HomeTeam.Score, false, rules)
+		home.FairPlayCoefficient += float32(fairPlayPoints(m.HomeTeam.Bookings))
+		away.FairPlayCoefficient += float32(fairPlayPoints(m.AwayTeam.Bookings))
+	}
+
+	groupIds := make([]string, 0, len(groups))
+	for id := range groups {
+		groupIds = append(groupIds, id)
+	}
+	sort.Strings(groupIds)
+	var out []StandingsResult
+	for _, id := range groupIds {
+		var teams []*StandingsResult
+		for _, r := range groups[id] {
+			teams = append(teams, r)
+		}
+		sort.Slice(teams, func(i, j int) bool {
+			return teams[i].Team.Id < teams[j].Team.Id
+		})
+		rk := &standingsRanker{rules: rules, matches: counted, lots: drawLots(teams, rules.LotsSeed), splits: splits}
+		for i, r := range rk.rank(teams, 0, len(teams)) {
+			r.Position = i + 1
+			out = append(out, *r)
+		}
+	}
+	return out
+}
+
+func (r *StandingsResult) addResult(m *MatchResponse, scored int, conceded int, home bool, rules StandingsRules) {
+	points := rules.PointsForDraw
+	result := 0
+	switch {
+	case scored > conceded:
+		points = rules.PointsForWin
+		result = 1
+		r.Won++
+	case scored < conceded:
+		points = rules.PointsForLoss
+		result = -1
+		r.Lost++
+	default:
+		r.Drawn++
+	}
+	r.Played++
+	r.For += scored
+	r.Against += conceded
+	r.GoalsDifference = r.For - r.Against
+	r.Points += points
+	if home {
+		r.HomePlayed++
+		r.HomeFor += scored
+		r.HomeAgainst += conceded
+		r.HomePoints += points
+		switch result {
+		case 1:
+			r.HomeWon++
+		case -1:
+			r.HomeLost++
+		default:
+			r.HomeDrawn++
+		}
+	} else {
+		r.AwayPlayed++
+		r.AwayFor += scored
+		r.AwayAgainst += conceded
+		r.AwayPoints += points
+		switch result {
+		case 1:
+			r.AwayWon++
+		case -1:
+			r.AwayLost++
+		default:
+			r.AwayDrawn++
+		}
+	}
+	if day, err := strconv.Atoi(m.MatchDay); err == nil && day > r.MatchDay {
+		r.MatchDay = day
+	}
+	if m.Date.After(r.Date) {
+		r.Date = m.Date
+	}
+	if r.StartDate.IsZero() || m.Date.Before(r.StartDate) {
+		r.StartDate = m.Date
+	}
+	if m.Date.After(r.EndDate) {
+		r.EndDate = m.Date
+	}
+	if m.Status == LIVE {
+		r.IsUpdateable = true
+	}
+	// Result is 1 for a win, 0 for a draw and -1 for a loss.
+	r.MatchResults = append(r.MatchResults, StandingsMatchResult{
+		MatchId:   m.Id,
+		StartTime: m.Date,
+		Result:    result,
+		GroupId:   m.GroupId,
+		StageId:   m.StageId,
+	})
+}
+
+// fairPlayPoints deducts, per player and match, 1 point for a yellow card, 3
+// for a second yellow, 4 for a direct red and 5 for a yellow followed by a
+// direct red. Only the heaviest deduction of a player counts.
+func fairPlayPoints(bookings []BookingResponse) int {
+	type cards struct{ yellow, secondYellow, red bool }
+	players := map[string]*cards{}
+	for _, b := range bookings {
+		if b.PlayerId == "" {
+			continue
+		}
+		c, ok := players[b.PlayerId]
+		if !ok {
+			c = &cards{}
+			players[b.PlayerId] = c
+		}
+		switch b.Card {
+		case YELLOW:
+			c.yellow = true
+		case YELLOW_RED:
+			c.secondYellow = true
+		case RED:
+			c.red = true
+		}
+	}
+	points := 0
+	for _, c := range players {
+		switch {
+		case c.red && (c.yellow || c.secondYellow):
+			points -= 5
+		case c.red:
+			points -= 4
+		case c.secondYellow:
+			points -= 3
+		case c.yellow:
+			points--
+		}
+	}
+	return points
+}
+
+func drawLots(teams []*StandingsResult, seed int64) map[string]int64 {
+	rnd := rand.New(rand.NewSource(seed))
+	lots := make(map[string]int64, len(teams))
+	for _, t := range teams {
+		lots[t.Team.Id] = rnd.Int63()
+	}
+	return lots
+}
+
+type standingsRanker struct {
+	rules   StandingsRules
+	matches []MatchResponse
+	lots    map[string]int64
+	// splits records, when set, the tie-breaker that placed each team below
+	// the team above it. Teams still level after every tie-breaker are
+	// recorded as separated by drawing of lots.
+	splits map[string]TieBreaker
+}
+
+// rank orders teams by the tie-breaker at idx and ranks every group still
+// level with the following ones. h2hSize is the number of teams the current
+// block of head-to-head criteria started with.
+func (rk *standingsRanker) rank(teams []*StandingsResult, idx int, h2hSize int) []*StandingsResult {
+	criteria := rk.rules.TieBreakers
+	if len(teams) <= 1 {
+		return teams
+	}
+	if idx >= len(criteria) {
+		if rk.splits != nil {
+			for _, t := range teams[1:] {
+				rk.splits[t.Team.Id] = TieBreakDrawingOfLots
+			}
+		}
+		return teams
+	}
+	criterion := criteria[idx]
+	if criterion.isHeadToHead() && (idx == 0 || !criteria[idx-1].isHeadToHead()) {
+		h2hSize = len(teams)
+	}
+	keys := rk.keys(criterion, teams)
+	sorted := append([]*StandingsResult(nil), teams...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return keys[sorted[i].Team.Id] > keys[sorted[j].Team.Id]
+	})
+	var out []*StandingsResult
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && keys[sorted[end].Team.Id] == keys[sorted[start].Team.Id] {
+			end++
+		}
+		level := sorted[start:end]
+		next := idx + 1
+		lastOfBlock := criterion.isHeadToHead() && (next >= len(criteria) || !criteria[next].isHeadToHead())
+		if lastOfBlock && rk.rules.ReapplyHeadToHead && len(level) > 1 && len(level) < h2hSize {
+			next = idx
+			for next > 0 && criteria[next-1].isHeadToHead() {
+				next--
+			}
+		}
+		ranked := rk.rank(level, next, h2hSize)
+		if start > 0 && rk.splits != nil {
+			rk.splits[ranked[0].Team.Id] = criterion
+		}
+		out = append(out, ranked...)
+		start = end
+	}
+	return out
+}
+
+func (rk *standingsRanker) keys(criterion TieBreaker, teams []*StandingsResult) map[string]int64 {
+	keys := make(map[string]int64, len(teams))
+	if criterion.isHeadToHead() {
+		in := make(map[string]bool, len(teams))
+		for _, t := range teams {
+			in[t.Team.Id] = true
+		}
+		mini := map[string]*StandingsResult{}
+		for _, t := range teams {
+			mini[t.Team.Id] = &StandingsResult{}
+		}
+		for i := range rk.matches {
+			m := &rk.matches[i]
+			if !in[m.HomeTeam.Id] || !in[m.AwayTeam.Id] {
+				continue
+			}
+			mini[m.HomeTeam.Id].addResult(m, m.HomeTeam.Score, m.AwayTeam.Score, true, rk.rules)
+			mini[m.AwayTeam.Id].addResult(m, m.AwayTeam.Score, m.HomeTeam.Score, false, rk.rules)
+		}
+		for id, r := range mini {
+			switch criterion {
+			case TieBreakHeadToHeadPoints:
+				keys[id] = int64(r.Points)
+			case TieBreakHeadToHeadGoalDifference:
+				keys[id] = int64(r.GoalsDifference)
+			case TieBreakHeadToHeadGoalsScored:
+				keys[id] = int64(r.For)
+			}
+		}
+		return keys
+	}
+	for _, t := range teams {
+		keys[t.Team.Id] = standingsKey(criterion, t, rk.lots)
+	}
+	return keys
+}
+
+func standingsKey(criterion TieBreaker, r *StandingsResult, lots map[string]int64) int64 {
+	switch criterion {
+	case TieBreakPoints:
+		return int64(r.Points)
+	case TieBreakGoalDifference:
+		return int64(r.GoalsDifference)
+	case TieBreakGoalsScored:
+		return int64(r.For)
+	case TieBreakWins:
+		return int64(r.Won)
+	case TieBreakFairPlay:
+		return int64(r.FairPlayCoefficient)
+	case TieBreakDrawingOfLots:
+		return lots[r.Team.Id]
+	}
+	return 0
+}
+
+// GetLocalStandings computes the group tables of a season from its calendar,
+// including matches in progress.
+func (c *Client) GetLocalStandings(opts *GetSeasonMatchesOptions, rules StandingsRules) ([]StandingsResult, error) {
+	matches, err := c.GetSeasonMatches(opts)
+	if err != nil {
+		return nil, err
+	}
+	return ComputeStandings(matches, rules), nil
+}
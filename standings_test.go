@@ -0,0 +1,113 @@
+package go_fifa_test
+
+import (
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func standingsOrder(rows []fifa.StandingsResult) []string {
+	var ids []string
+	for _, r := range rows {
+		ids = append(ids, r.Team.Id)
+	}
+	return ids
+}
+
+func TestComputeStandings(t *testing.T) {
+	t.Parallel()
+	matches := []fifa.MatchResponse{
+		testMatch("1", "A", 1, "B", 0, inGroupId("A")),
+		testMatch("2", "C", 1, "D", 1, inGroupId("A")),
+		testMatch("3", "A", 0, "C", 1, inGroupId("A")),
+		testMatch("4", "B", 2, "D", 0, inGroupId("A")),
+		testMatch("5", "A", 1, "D", 1, inGroupId("A")),
+		testMatch("6", "B", 1, "C", 0, inGroupId("A")),
+	}
+	rows := fifa.ComputeStandings(matches, fifa.WorldCupStandingsRules)
+	assert.Equal(t, []string{"B", "C", "A", "D"}, standingsOrder(rows), "C is level with A but won the head-to-head")
+	b := rows[0]
+	assert.Equal(t, 1, b.Position)
+	assert.Equal(t, 6, b.Points)
+	assert.Equal(t, 3, b.Played)
+	assert.Equal(t, 2, b.Won)
+	assert.Equal(t, 1, b.Lost)
+	assert.Equal(t, 3, b.For)
+	assert.Equal(t, 1, b.Against)
+	assert.Equal(t, 2, b.GoalsDifference)
+	assert.Equal(t, 2, b.HomeWon)
+	assert.Equal(t, 1, b.AwayLost)
+	assert.Len(t, b.MatchResults, 3)
+}
+
+func TestComputeStandingsHeadToHeadFirst(t *testing.T) {
+	t.Parallel()
+	matches := []fifa.MatchResponse{
+		testMatch("1", "A", 5, "C", 0, inGroupId("A")),
+		testMatch("2", "B", 1, "A", 0, inGroupId("A")),
+		testMatch("3", "A", 1, "D", 0, inGroupId("A")),
+		testMatch("4", "C", 1, "B", 0, inGroupId("A")),
+		testMatch("5", "B", 1, "D", 0, inGroupId("A")),
+		testMatch("6", "C", 0, "D", 0, inGroupId("A")),
+	}
+	assert.Equal(t, []string{"A", "B", "C", "D"}, standingsOrder(fifa.ComputeStandings(matches, fifa.WorldCupStandingsRules)))
+	assert.Equal(t, []string{"B", "A", "C", "D"}, standingsOrder(fifa.ComputeStandings(matches, fifa.EuroStandingsRules)))
+}
+
+func TestComputeStandingsFairPlay(t *testing.T) {
+	t.Parallel()
+	m := testMatch("1", "X", 1, "Y", 1, inGroupId("A"))
+	m.HomeTeam.Bookings = []fifa.BookingResponse{
+		{Card: fifa.YELLOW, PlayerId: "x1"},
+		{Card: fifa.RED, PlayerId: "x1"},
+	}
+	m.AwayTeam.Bookings = []fifa.BookingResponse{
+		{Card: fifa.YELLOW, PlayerId: "y1"},
+		{Card: fifa.YELLOW, PlayerId: "y1"},
+		{Card: fifa.YELLOW_RED, PlayerId: "y1"},
+		{Card: fifa.YELLOW, PlayerId: "y2"},
+	}
+	rows := fifa.ComputeStandings([]fifa.MatchResponse{m}, fifa.WorldCupStandingsRules)
+	if ok := assert.Len(t, rows, 2); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "Y", rows[0].Team.Id)
+	assert.Equal(t, float32(-4), rows[0].FairPlayCoefficient)
+	assert.Equal(t, float32(-5), rows[1].FairPlayCoefficient)
+}
+
+func TestComputeStandingsDrawingOfLots(t *testing.T) {
+	t.Parallel()
+	matches := []fifa.MatchResponse{testMatch("1", "X", 0, "Y", 0, inGroupId("A"))}
+	rules := fifa.WorldCupStandingsRules
+	first := standingsOrder(fifa.ComputeStandings(matches, rules))
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, first, standingsOrder(fifa.ComputeStandings(matches, rules)))
+	}
+	seen := map[string]bool{}
+	for seed := int64(0); seed < 20; seed++ {
+		rules.LotsSeed = seed
+		seen[standingsOrder(fifa.ComputeStandings(matches, rules))[0]] = true
+	}
+	assert.Len(t, seen, 2, "different seeds should be able to draw either team")
+}
+
+func TestComputeStandingsLive(t *testing.T) {
+	t.Parallel()
+	live := testMatch("2", "Y", 2, "X", 0, inGroupId("A"))
+	live.Status = fifa.LIVE
+	upcoming := testMatch("3", "Z", 0, "X", 0, inGroupId("A"))
+	upcoming.Status = fifa.TO_BE_PLAYED
+	matches := []fifa.MatchResponse{
+		testMatch("1", "X", 1, "Y", 0, inGroupId("A")),
+		live,
+		upcoming,
+	}
+	rows := fifa.ComputeStandings(matches, fifa.WorldCupStandingsRules)
+	assert.Equal(t, []string{"Y", "X", "Z"}, standingsOrder(rows))
+	assert.Equal(t, 3, rows[0].PreviousPosition, "Y was behind Z on goal difference before kick-off")
+	assert.True(t, rows[0].IsUpdateable)
+	assert.Equal(t, 1, rows[1].PreviousPosition)
+	assert.Equal(t, 0, rows[2].Played)
+}
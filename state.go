@@ -0,0 +1,299 @@
+package go_fifa
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrIllegalTransition = errors.New("illegal match state transition")
+
+type MatchPhase int
+
+const (
+	PhaseUnknown MatchPhase = iota
+	PhaseScheduled
+	PhaseFirstHalf
+	PhaseHalfTime
+	PhaseSecondHalf
+	PhaseExtraTimeBreak
+	PhaseExtraTime
+	PhaseShootout
+	PhaseFinished
+	PhaseAbandoned
+	PhasePostponed
+	PhaseCancelled
+	PhaseShootoutBreak
+)
+
+var matchPhaseNames = map[MatchPhase]string{
+	PhaseUnknown:        "unknown",
+	PhaseScheduled:      "scheduled",
+	PhaseFirstHalf:      "first half",
+	PhaseHalfTime:       "half-time",
+	PhaseSecondHalf:     "second half",
+	PhaseExtraTimeBreak: "extra time break",
+	PhaseExtraTime:      "extra time",
+	PhaseShootout:       "shootout",
+	PhaseFinished:       "finished",
+	PhaseAbandoned:      "abandoned",
+	PhasePostponed:      "postponed",
+	PhaseCancelled:      "cancelled",
+	PhaseShootoutBreak:  "shootout break",
+}
+
+func (p MatchPhase) String() string {
+	if name, ok := matchPhaseNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("MatchPhase(%d)", int(p))
+}
+
+// IsRunning reports whether the match clock is running during the phase.
+func (p MatchPhase) IsRunning() bool {
+	return p == PhaseFirstHalf || p == PhaseSecondHalf || p == PhaseExtraTime
+}
+
+// IsTerminal reports whether the match can no longer change phase.
+func (p MatchPhase) IsTerminal() bool {
+	return p == PhaseFinished || p == PhaseAbandoned || p == PhaseCancelled
+}
+
+// matchPhaseTransitions lists the phases each phase may move to. Forward skips
+// are allowed because polled feeds can miss short phases such as half-time.
+var matchPhaseTransitions = map[MatchPhase][]MatchPhase{
+	PhaseScheduled:      {PhaseFirstHalf, PhaseHalfTime, PhaseSecondHalf, PhaseFinished, PhaseAbandoned, PhasePostponed, PhaseCancelled},
+	PhaseFirstHalf:      {PhaseHalfTime, PhaseSecondHalf, PhaseFinished, PhaseAbandoned},
+	PhaseHalfTime:       {PhaseSecondHalf, PhaseExtraTimeBreak, PhaseFinished, PhaseAbandoned},
+	PhaseSecondHalf:     {PhaseExtraTimeBreak, PhaseExtraTime, PhaseShootoutBreak, PhaseShootout, PhaseFinished, PhaseAbandoned},
+	PhaseExtraTimeBreak: {PhaseExtraTime, PhaseShootoutBreak, PhaseShootout, PhaseFinished, PhaseAbandoned},
+	PhaseExtraTime:      {PhaseExtraTimeBreak, PhaseShootoutBreak, PhaseShootout, PhaseFinished, PhaseAbandoned},
+	PhaseShootoutBreak:  {PhaseShootout, PhaseFinished, PhaseAbandoned},
+	PhaseShootout:       {PhaseFinished, PhaseAbandoned},
+	PhasePostponed:      {PhaseScheduled, PhaseCancelled},
+}
+
+// ValidateTransition returns ErrIllegalTransition if a feed may not move from
+// one phase to the other.
+func ValidateTransition(from MatchPhase, to MatchPhase) error {
+	if from == to || from == PhaseUnknown || to == PhaseUnknown {
+		return nil
+	}
+	for _, p := range matchPhaseTransitions[from] {
+		if p == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s to %s", ErrIllegalTransition, from, to)
+}
+
+// MatchState is a snapshot of a match's phase and clock as observed at a
+// point in time.
+type MatchState struct {
+	Phase      MatchPhase
+	Period     PeriodEnum
+	Minute     MatchMinute
+	HomeScore  int
+	AwayScore  int
+	ObservedAt time.Time
+}
+
+// tieLeg describes the leg of a two-legged tie a match is.
+type tieLeg struct {
+	twoLegged bool
+	last      bool
+	// level reports whether the aggregate score is level.
+	level bool
+}
+
+// aggregateLeg treats a match carrying an aggregate score as the second leg
+// of a tie. First legs carry none and cannot be told from single matches.
+func aggregateLeg(home int, away int) tieLeg {
+	if home == 0 && away == 0 {
+		return tieLeg{}
+	}
+	return tieLeg{twoLegged: true, last: true, level: home == away}
+}
+
+// NewMatchState reads the state of a match, telling knockout matches from
+// others by their stage name and second legs by their aggregate score. Use
+// NewMatchStateForStage when the stage type is known and NewMatchStateForTie
+// for legs of a tie.
+func NewMatchState(m *MatchResponse, observedAt time.Time) MatchState {
+	return NewMatchStateForStage(m, stageTypeFromNames(m.StageName), observedAt)
+}
+
+// NewMatchStateForStage reads the state of a match in a stage of the given
+// type. Only knockout matches level at the end of normal time go to extra
+// time; with an unknown stage type that phase is reported as unknown.
+func NewMatchStateForStage(m *MatchResponse, stage StageType, observedAt time.Time) MatchState {
+	leg := aggregateLeg(m.AggregateHomeTeamScore, m.AggregateAwayTeamScore)
+	return newMatchState(m.Status, m.Period, m.MatchTime, m.HomeTeam.Score, m.AwayTeam.Score, stage, leg, observedAt)
+}
+
+// NewMatchStateForTie reads the state of a leg of a knockout tie. A first leg
+// ends after normal time; the last leg of a two-legged tie only goes on when
+// the aggregate is level, and since away goals may still decide it that phase
+// is reported as unknown.
+func NewMatchStateForTie(m *MatchResponse, tie *BracketTie, observedAt time.Time) MatchState {
+	if tie == nil {
+		return NewMatchState(m, observedAt)
+	}
+	leg := tieLeg{}
+	if tie.TwoLegged() {
+		leg = tieLeg{
+			twoLegged: true,
+			last:      tie.Legs[len(tie.Legs)-1].Id == m.Id,
+			level:     tie.HomeScore == tie.AwayScore,
+		}
+	}
+	return newMatchState(m.Status, m.Period, m.MatchTime, m.HomeTeam.Score, m.AwayTeam.Score, KnockoutStage, leg, observedAt)
+}
+
+func NewMatchDataState(m *MatchDataResponse, observedAt time.Time) MatchState {
+	return NewMatchDataStateForStage(m, stageTypeFromNames(m.StageName), observedAt)
+}
+
+func NewMatchDataStateForStage(m *MatchDataResponse, stage StageType, observedAt time.Time) MatchState {
+	leg := aggregateLeg(m.AggregateHomeTeamScore, m.AggregateAwayTeamScore)
+	return newMatchState(m.MatchStatus, m.Period, m.MatchTime, m.HomeTeam.Score, m.AwayTeam.Score, stage, leg, observedAt)
+}
+
+func newMatchState(status MatchStatus, period PeriodEnum, matchTime string, home int, away int, stage StageType, leg tieLeg, observedAt time.Time) MatchState {
+	minute, _ := ParseMatchMinute(matchTime)
+	return MatchState{
+		Phase:      matchPhase(status, period, home == away, stage, leg),
+		Period:     period,
+		Minute:     minute.WithPeriod(period),
+		HomeScore:  home,
+		AwayScore:  away,
+		ObservedAt: observedAt,
+	}
+}
+
+func matchPhase(status MatchStatus, period PeriodEnum, level bool, stage StageType, leg tieLeg) MatchPhase {
+	switch status {
+	case PLAYED:
+		return PhaseFinished
+	case TO_BE_PLAYED, LINEUPS:
+		return PhaseScheduled
+	case ABANDONED:
+		return PhaseAbandoned
+	case POSTPONED:
+		return PhasePostponed
+	case CANCELLED:
+		return PhaseCancelled
+	case LIVE:
+	default:
+		return PhaseUnknown
+	}
+	switch period {
+	case 0, 1, 2:
+		return PhaseScheduled
+	case FIRST:
+		return PhaseFirstHalf
+	case HALF_TIME:
+		return PhaseHalfTime
+	case SECOND:
+		return PhaseSecondHalf
+	case END_OF_SECOND:
+		switch {
+		case leg.twoLegged && (!leg.last || !leg.level):
+			return PhaseFinished
+		case leg.twoLegged:
+			return PhaseUnknown
+		case !level || stage == GroupStage:
+			return PhaseFinished
+		case stage == KnockoutStage:
+			return PhaseExtraTimeBreak
+		}
+		return PhaseUnknown
+	case END_OF_EXTRA:
+		if leg.twoLegged {
+			level = leg.level
+		}
+		if !level {
+			return PhaseFinished
+		}
+		return PhaseShootoutBreak
+	case EXTRA_HALF_TIME:
+		return PhaseExtraTimeBreak
+	case FIRST_EXTRA, SECOND_EXTRA:
+		return PhaseExtraTime
+	case SHOOTOUT:
+		return PhaseShootout
+	}
+	return PhaseUnknown
+}
+
+var periodEndMinute = map[PeriodEnum]int{
+	FIRST:        45,
+	SECOND:       90,
+	FIRST_EXTRA:  105,
+	SECOND_EXTRA: 120,
+}
+
+// Clock returns the running match clock at the given instant, advancing the
+// observed minute while the clock runs and counting minutes past the end of a
+// period as added time.
+func (s MatchState) Clock(at time.Time) MatchMinute {
+	if !s.Phase.IsRunning() || s.Minute.IsZero() {
+		return s.Minute
+	}
+	elapsed := at.Sub(s.ObservedAt)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	total := s.Minute.Minute + s.Minute.Added + int(elapsed/time.Minute)
+	clock := MatchMinute{Minute: total, Period: s.Minute.Period}
+	if end, ok := periodEndMinute[clock.Period]; ok && total > end {
+		clock.Minute = end
+		clock.Added = total - end
+	}
+	return clock
+}
+
+// Label returns a short display label such as "67'", "HT", "ET 105'+1'" or
+// "Penalties" for the state at the given instant.
+func (s MatchState) Label(at time.Time) string {
+	switch s.Phase {
+	case PhaseScheduled:
+		return "Scheduled"
+	case PhaseFirstHalf, PhaseSecondHalf:
+		return s.Clock(at).String()
+	case PhaseHalfTime:
+		return "HT"
+	case PhaseExtraTimeBreak:
+		if s.Period == EXTRA_HALF_TIME {
+			return "ET HT"
+		}
+		return "ET Break"
+	case PhaseExtraTime:
+		return "ET " + s.Clock(at).String()
+	case PhaseShootoutBreak:
+		return "Pens Break"
+	case PhaseShootout:
+		return "Penalties"
+	case PhaseFinished:
+		return "FT"
+	case PhaseAbandoned:
+		return "Abandoned"
+	case PhasePostponed:
+		return "Postponed"
+	case PhaseCancelled:
+		return "Cancelled"
+	}
+	return ""
+}
+
+// Transition validates that next may follow s in a live feed: the phase must
+// be reachable and, within the same period, the clock must not run backwards.
+func (s MatchState) Transition(next MatchState) error {
+	if err := ValidateTransition(s.Phase, next.Phase); err != nil {
+		return err
+	}
+	if s.Phase == next.Phase && s.Period == next.Period && next.Minute.Before(s.Minute) {
+		return fmt.Errorf("%w: clock went back from %s to %s", ErrIllegalTransition, s.Minute, next.Minute)
+	}
+	return nil
+}
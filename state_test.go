@@ -0,0 +1,122 @@
+package go_fifa_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMatchState(t *testing.T) {
+	t.Parallel()
+	observed := time.Date(2022, 12, 18, 15, 30, 0, 0, time.UTC)
+	cases := []struct {
+		status fifa.MatchStatus
+		stage  fifa.StageType
+		period fifa.PeriodEnum
+		time   string
+		home   int
+		away   int
+		phase  fifa.MatchPhase
+		label  string
+	}{
+		{fifa.TO_BE_PLAYED, fifa.KnockoutStage, 0, "", 0, 0, fifa.PhaseScheduled, "Scheduled"},
+		{fifa.LIVE, fifa.KnockoutStage, fifa.FIRST, "23'", 1, 0, fifa.PhaseFirstHalf, "23'"},
+		{fifa.LIVE, fifa.KnockoutStage, fifa.HALF_TIME, "45'+7'", 2, 0, fifa.PhaseHalfTime, "HT"},
+		{fifa.LIVE, fifa.KnockoutStage, fifa.SECOND, "81'", 2, 2, fifa.PhaseSecondHalf, "81'"},
+		{fifa.LIVE, fifa.KnockoutStage, fifa.END_OF_SECOND, "90'+8'", 2, 2, fifa.PhaseExtraTimeBreak, "ET Break"},
+		{fifa.LIVE, fifa.KnockoutStage, fifa.END_OF_SECOND, "90'+4'", 2, 1, fifa.PhaseFinished, "FT"},
+		{fifa.LIVE, fifa.KnockoutStage, fifa.FIRST_EXTRA, "105'+1'", 2, 2, fifa.PhaseExtraTime, "ET 105'+1'"},
+		{fifa.LIVE, fifa.KnockoutStage, fifa.SHOOTOUT, "120'+3'", 3, 3, fifa.PhaseShootout, "Penalties"},
+		{fifa.PLAYED, fifa.KnockoutStage, fifa.END_OF_EXTRA, "", 3, 3, fifa.PhaseFinished, "FT"},
+		{fifa.LIVE, fifa.GroupStage, fifa.END_OF_SECOND, "90'+6'", 1, 1, fifa.PhaseFinished, "FT"},
+		{fifa.LIVE, fifa.UnknownStage, fifa.END_OF_SECOND, "90'+6'", 1, 1, fifa.PhaseUnknown, ""},
+		{fifa.LIVE, fifa.KnockoutStage, fifa.END_OF_EXTRA, "120'+2'", 2, 2, fifa.PhaseShootoutBreak, "Pens Break"},
+		{fifa.LIVE, fifa.KnockoutStage, fifa.END_OF_EXTRA, "120'+2'", 3, 2, fifa.PhaseFinished, "FT"},
+		{fifa.POSTPONED, fifa.KnockoutStage, 0, "", 0, 0, fifa.PhasePostponed, "Postponed"},
+		{fifa.ABANDONED, fifa.KnockoutStage, fifa.SECOND, "60'", 0, 0, fifa.PhaseAbandoned, "Abandoned"},
+	}
+	for _, c := range cases {
+		m := &fifa.MatchResponse{Status: c.status, Period: c.period, MatchTime: c.time}
+		m.HomeTeam.Score = c.home
+		m.AwayTeam.Score = c.away
+		state := fifa.NewMatchStateForStage(m, c.stage, observed)
+		assert.Equal(t, c.phase, state.Phase, "unexpected phase for status %d period %d", c.status, c.period)
+		assert.Equal(t, c.label, state.Label(observed), "unexpected label for status %d period %d", c.status, c.period)
+	}
+}
+
+func TestMatchStateClock(t *testing.T) {
+	t.Parallel()
+	observed := time.Date(2022, 12, 18, 15, 30, 0, 0, time.UTC)
+	m := &fifa.MatchResponse{Status: fifa.LIVE, Period: fifa.FIRST, MatchTime: "43'"}
+	state := fifa.NewMatchState(m, observed)
+	assert.Equal(t, "43'", state.Clock(observed.Add(-time.Minute)).String())
+	assert.Equal(t, "44'", state.Clock(observed.Add(90*time.Second)).String())
+	assert.Equal(t, "45'+1'", state.Clock(observed.Add(3*time.Minute)).String())
+
+	m.Period = fifa.HALF_TIME
+	m.MatchTime = "45'+3'"
+	state = fifa.NewMatchState(m, observed)
+	assert.Equal(t, "45'+3'", state.Clock(observed.Add(10*time.Minute)).String(), "clock should not run at half-time")
+}
+
+func TestMatchStateTransitions(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, fifa.ValidateTransition(fifa.PhaseFirstHalf, fifa.PhaseHalfTime))
+	assert.Nil(t, fifa.ValidateTransition(fifa.PhaseFirstHalf, fifa.PhaseSecondHalf), "polled feeds may miss half-time")
+	assert.Nil(t, fifa.ValidateTransition(fifa.PhaseExtraTime, fifa.PhaseExtraTimeBreak))
+	err := fifa.ValidateTransition(fifa.PhaseSecondHalf, fifa.PhaseFirstHalf)
+	assert.True(t, errors.Is(err, fifa.ErrIllegalTransition))
+	assert.NotNil(t, fifa.ValidateTransition(fifa.PhaseFinished, fifa.PhaseSecondHalf))
+
+	observed := time.Now()
+	before := fifa.NewMatchState(&fifa.MatchResponse{Status: fifa.LIVE, Period: fifa.SECOND, MatchTime: "70'"}, observed)
+	after := fifa.NewMatchState(&fifa.MatchResponse{Status: fifa.LIVE, Period: fifa.SECOND, MatchTime: "68'"}, observed)
+	err = before.Transition(after)
+	assert.True(t, errors.Is(err, fifa.ErrIllegalTransition), "clock going backwards should be rejected")
+	assert.Nil(t, after.Transition(before))
+}
+
+func TestNewMatchStateTwoLegged(t *testing.T) {
+	t.Parallel()
+	observed := time.Date(2022, 3, 9, 21, 50, 0, 0, time.UTC)
+	first := fifa.MatchResponse{Id: "1", Status: fifa.LIVE, Period: fifa.END_OF_SECOND, MatchTime: "90'+4'"}
+	first.HomeTeam.Score = 1
+	first.AwayTeam.Score = 1
+	second := fifa.MatchResponse{Id: "2", Status: fifa.LIVE, Period: fifa.END_OF_SECOND, MatchTime: "90'+5'"}
+	second.HomeTeam.Score = 2
+	second.AwayTeam.Score = 2
+	tie := &fifa.BracketTie{Legs: []fifa.MatchResponse{first, second}, HomeScore: 3, AwayScore: 3}
+
+	assert.Equal(t, fifa.PhaseFinished, fifa.NewMatchStateForTie(&first, tie, observed).Phase, "a level first leg ends after normal time")
+	assert.Equal(t, fifa.PhaseUnknown, fifa.NewMatchStateForTie(&second, tie, observed).Phase, "away goals may decide a level aggregate")
+
+	tie.HomeScore = 4
+	assert.Equal(t, fifa.PhaseFinished, fifa.NewMatchStateForTie(&second, tie, observed).Phase, "a level second leg ends when the aggregate is decided")
+
+	second.AggregateHomeTeamScore = 4
+	second.AggregateAwayTeamScore = 3
+	assert.Equal(t, fifa.PhaseFinished, fifa.NewMatchStateForStage(&second, fifa.KnockoutStage, observed).Phase, "the aggregate score marks a second leg")
+
+	second.Period = fifa.END_OF_EXTRA
+	second.AggregateAwayTeamScore = 4
+	assert.Equal(t, fifa.PhaseShootoutBreak, fifa.NewMatchStateForStage(&second, fifa.KnockoutStage, observed).Phase)
+}
+
+func TestNewMatchStateStageName(t *testing.T) {
+	t.Parallel()
+	observed := time.Date(2022, 12, 18, 15, 30, 0, 0, time.UTC)
+	m := &fifa.MatchResponse{Status: fifa.LIVE, Period: fifa.END_OF_SECOND, MatchTime: "90'+7'"}
+	m.StageName = []fifa.DefaultDescriptionResponse{{Locale: "en-GB", Description: "Final"}}
+	assert.Equal(t, fifa.PhaseExtraTimeBreak, fifa.NewMatchState(m, observed).Phase)
+
+	m.StageName = []fifa.DefaultDescriptionResponse{{Locale: "en-GB", Description: "First Stage"}}
+	assert.Equal(t, fifa.PhaseFinished, fifa.NewMatchState(m, observed).Phase, "group matches level after 90 minutes are over")
+
+	assert.Nil(t, fifa.ValidateTransition(fifa.PhaseExtraTime, fifa.PhaseShootoutBreak))
+	assert.Nil(t, fifa.ValidateTransition(fifa.PhaseShootoutBreak, fifa.PhaseShootout))
+	assert.NotNil(t, fifa.ValidateTransition(fifa.PhaseShootoutBreak, fifa.PhaseExtraTime))
+}
@@ -0,0 +1,360 @@
+package go_fifa
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+const defaultStatsWorkers = 8
+
+type PlayerStat int
+
+const (
+	StatGoals PlayerStat = iota
+	StatPenalties
+	StatOwnGoals
+	StatAssists
+	StatYellowCards
+	StatRedCards
+	StatSaves
+)
+
+type TeamStat int
+
+const (
+	TeamStatGoalsFor TeamStat = iota
+	TeamStatGoalsAgainst
+	TeamStatCleanSheets
+	TeamStatYellowCards
+	TeamStatRedCards
+)
+
+type PlayerSeasonStats struct {
+	PlayerId string
+	TeamId   string
+	// Matches and Minutes are only counted for matches added with their
+	// line-ups, such as those from GetCurrentMatches.
+	Matches     int
+	Minutes     int
+	Goals       int
+	Penalties   int
+	OwnGoals    int
+	Assists     int
+	YellowCards int
+	RedCards    int
+	Saves       int
+}
+
+func (p PlayerSeasonStats) Value(stat PlayerStat) int {
+	switch stat {
+	case StatGoals:
+		return p.Goals
+	case StatPenalties:
+		return p.Penalties
+	case StatOwnGoals:
+		return p.OwnGoals
+	case StatAssists:
+		return p.Assists
+	case StatYellowCards:
+		return p.YellowCards
+	case StatRedCards:
+		return p.RedCards
+	case StatSaves:
+		return p.Saves
+	}
+	return 0
+}
+
+type TeamSeasonStats struct {
+	TeamId       string
+	Played       int
+	GoalsFor     int
+	GoalsAgainst int
+	CleanSheets  int
+	YellowCards  int
+	RedCards     int
+}
+
+func (t TeamSeasonStats) Value(stat TeamStat) int {
+	switch stat {
+	case TeamStatGoalsFor:
+		return t.GoalsFor
+	case TeamStatGoalsAgainst:
+		return t.GoalsAgainst
+	case TeamStatCleanSheets:
+		return t.CleanSheets
+	case TeamStatYellowCards:
+		return t.YellowCards
+	case TeamStatRedCards:
+		return t.RedCards
+	}
+	return 0
+}
+
+type SeasonStatsOptions struct {
+	CompetitionId string
+	SeasonId      string
+	// Workers is the number of timelines fetched concurrently.
+	Workers int
+}
+
+// SeasonStats aggregates player and team statistics over the finished matches
+// of a season. Call Update to fetch matches that finished since the last call.
+type SeasonStats struct {
+	client    *Client
+	options   SeasonStatsOptions
+	mu        sync.Mutex
+	processed map[string]bool
+	players   map[string]*PlayerSeasonStats
+	teams     map[string]*TeamSeasonStats
+}
+
+func NewSeasonStats(client *Client, opts *SeasonStatsOptions) *SeasonStats {
+	s := &SeasonStats{
+		client:    client,
+		processed: map[string]bool{},
+		players:   map[string]*PlayerSeasonStats{},
+		teams:     map[string]*TeamSeasonStats{},
+	}
+	if opts != nil {
+		s.options = *opts
+	}
+	if s.options.Workers <= 0 {
+		s.options.Workers = defaultStatsWorkers
+	}
+	return s
+}
+
+// Update fetches the season calendar and the timelines of finished matches
+// that have not been processed yet. It returns the number of matches added;
+// matches whose timeline could not be fetched are retried on the next call.
+func (s *SeasonStats) Update() (int, error) {
+	matches, err := s.client.GetSeasonMatches(&GetSeasonMatchesOptions{
+		CompetitionId: s.options.CompetitionId,
+		SeasonId:      s.options.SeasonId,
+	})
+	if err != nil {
+		return 0, err
+	}
+	var pending []MatchResponse
+	s.mu.Lock()
+	for _, m := range matches {
+		if m.Status == PLAYED && !s.processed[m.Id] {
+			pending = append(pending, m)
+		}
+	}
+	s.mu.Unlock()
+
+	refs := make([]MatchRef, len(pending))
+	for i, m := range pending {
+		refs[i] = m.Ref()
+	}
+	var errs []error
+	added := 0
+	results := s.client.GetMatchEventsBatch(context.Background(), refs, &BatchOptions{Workers: s.options.Workers})
+	for i, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("match %s: %w", pending[i].Id, r.Err))
+			continue
+		}
+		if s.AddMatch(&pending[i], r.Events) {
+			added++
+		}
+	}
+	if len(errs) > 0 {
+		return added, fmt.Errorf("failed to fetch %d of %d timelines: %w", len(errs), len(pending), errs[0])
+	}
+	return added, nil
+}
+
+// AddMatch adds a finished match and its timeline to the statistics. It
+// returns false if the match was already added.
+func (s *SeasonStats) AddMatch(m *MatchResponse, events *GetMatchEventsResponse) bool {
+	timeline := NewTimeline(events, &m.HomeTeam, &m.AwayTeam)
+	playing := ComputePlayingTime(m)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.processed[m.Id] {
+		return false
+	}
+	s.processed[m.Id] = true
+
+	for _, p := range playing.Players {
+		ps := s.player(p.PlayerId, p.TeamId)
+		ps.Matches++
+		ps.Minutes += p.Minutes
+	}
+	s.addTeamResult(m.HomeTeam.Id, m.HomeTeam.Score, m.AwayTeam.Score)
+	s.addTeamResult(m.AwayTeam.Id, m.AwayTeam.Score, m.HomeTeam.Score)
+
+	for _, e := range timeline.Entries {
+		ev := e.Event
+		if ev.Period == SHOOTOUT {
+			continue
+		}
+		switch ev.Type {
+		case GoalScore, FreeKickGoal, PenaltyGoal:
+			if e.Disallowed || ev.PlayerId == "" {
+				continue
+			}
+			ps := s.player(ev.PlayerId, ev.TeamId)
+			ps.Goals++
+			if ev.Type == PenaltyGoal {
+				ps.Penalties++
+			}
+		case OwnGoal:
+			if e.Disallowed || ev.PlayerId == "" {
+				continue
+			}
+			s.player(ev.PlayerId, ev.TeamId).OwnGoals++
+		case Assist:
+			if ev.PlayerId != "" {
+				s.player(ev.PlayerId, ev.TeamId).Assists++
+			}
+		case YellowCard:
+			s.team(ev.TeamId).YellowCards++
+			if ev.PlayerId != "" {
+				s.player(ev.PlayerId, ev.TeamId).YellowCards++
+			}
+		case RedCard, DoubleYellow:
+			s.team(ev.TeamId).RedCards++
+			if ev.PlayerId != "" {
+				s.player(ev.PlayerId, ev.TeamId).RedCards++
+			}
+		case GoalieSaved:
+			// The goalkeeper is the event's sub player when present.
+			keeper := ev.SubPlayerId
+			team := ev.SubTeamId
+			if keeper == "" {
+				keeper = ev.PlayerId
+				team = ev.TeamId
+			}
+			if keeper != "" {
+				s.player(keeper, team).Saves++
+			}
+		}
+	}
+	return true
+}
+
+func (s *SeasonStats) addTeamResult(teamId string, scored int, conceded int) {
+	if teamId == "" {
+		return
+	}
+	t := s.team(teamId)
+	t.Played++
+	t.GoalsFor += scored
+	t.GoalsAgainst += conceded
+	if conceded == 0 {
+		t.CleanSheets++
+	}
+}
+
+func (s *SeasonStats) player(id string, teamId string) *PlayerSeasonStats {
+	p, ok := s.players[id]
+	if !ok {
+		p = &PlayerSeasonStats{PlayerId: id}
+		s.players[id] = p
+	}
+	if p.TeamId == "" {
+		p.TeamId = teamId
+	}
+	return p
+}
+
+func (s *SeasonStats) team(id string) *TeamSeasonStats {
+	t, ok := s.teams[id]
+	if !ok {
+		t = &TeamSeasonStats{TeamId: id}
+		s.teams[id] = t
+	}
+	return t
+}
+
+// PlayerLeaderboard returns up to n players ranked by the given statistic,
+// leaving out players without any. Ties on goals are split by assists, as for
+// the Golden Boot; minutes played are not used since calendar matches carry no
+// line-ups.
+func (s *SeasonStats) PlayerLeaderboard(stat PlayerStat, n int) []PlayerSeasonStats {
+	s.mu.Lock()
+	var out []PlayerSeasonStats
+	for _, p := range s.players {
+		if p.Value(stat) > 0 {
+			out = append(out, *p)
+		}
+	}
+	s.mu.Unlock()
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Value(stat) != b.Value(stat) {
+			return a.Value(stat) > b.Value(stat)
+		}
+		if stat == StatGoals && a.Assists != b.Assists {
+			return a.Assists > b.Assists
+		}
+		return a.PlayerId < b.PlayerId
+	})
+	if n > 0 && len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+// TeamLeaderboard returns up to n teams ranked by the given statistic, most
+// first as for players, so cards list the most carded teams first. Goals
+// against rank ascending; ties are broken by fewer matches played.
+func (s *SeasonStats) TeamLeaderboard(stat TeamStat, n int) []TeamSeasonStats {
+	s.mu.Lock()
+	var out []TeamSeasonStats
+	for _, t := range s.teams {
+		out = append(out, *t)
+	}
+	s.mu.Unlock()
+	ascending := stat == TeamStatGoalsAgainst
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Value(stat) != b.Value(stat) {
+			if ascending {
+				return a.Value(stat) < b.Value(stat)
+			}
+			return a.Value(stat) > b.Value(stat)
+		}
+		if a.Played != b.Played {
+			return a.Played < b.Played
+		}
+		return a.TeamId < b.TeamId
+	})
+	if n > 0 && len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+func (s *SeasonStats) TopScorers(n int) []PlayerSeasonStats {
+	return s.PlayerLeaderboard(StatGoals, n)
+}
+
+func (s *SeasonStats) Player(playerId string) *PlayerSeasonStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.players[playerId]
+	if !ok {
+		return nil
+	}
+	out := *p
+	return &out
+}
+
+func (s *SeasonStats) Team(teamId string) *TeamSeasonStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.teams[teamId]
+	if !ok {
+		return nil
+	}
+	out := *t
+	return &out
+}
@@ -0,0 +1,96 @@
+package go_fifa_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+const statsCalendar = `{"Results":[
+	{"IdMatch":"m1","IdCompetition":"17","IdSeason":"s","IdStage":"g","MatchStatus":0,
+	 "HomeTeam":{"TeamId":"A","Score":2},"AwayTeam":{"TeamId":"B","Score":0}},
+	{"IdMatch":"m2","IdCompetition":"17","IdSeason":"s","IdStage":"g","MatchStatus":0,
+	 "HomeTeam":{"TeamId":"B","Score":1},"AwayTeam":{"TeamId":"C","Score":1}},
+	{"IdMatch":"m3","IdCompetition":"17","IdSeason":"s","IdStage":"g","MatchStatus":1,
+	 "HomeTeam":{"TeamId":"A"},"AwayTeam":{"TeamId":"C"}}
+]}`
+
+const statsTimelineM1 = `{"Event":[
+	{"Type":0,"Period":3,"MatchMinute":"10'","IdTeam":"A","IdPlayer":"a9","HomeGoals":1},
+	{"Type":1,"Period":3,"MatchMinute":"10'","IdTeam":"A","IdPlayer":"a10","HomeGoals":1},
+	{"Type":2,"Period":3,"MatchMinute":"30'","IdTeam":"B","IdPlayer":"b4","HomeGoals":1},
+	{"Type":41,"Period":5,"MatchMinute":"80'","IdTeam":"A","IdPlayer":"a10","HomeGoals":2},
+	{"Type":57,"Period":5,"MatchMinute":"85'","IdTeam":"A","IdPlayer":"a9","IdSubTeam":"B","IdSubPlayer":"b1","HomeGoals":2}
+]}`
+
+const statsTimelineM2 = `{"Event":[
+	{"Type":0,"Period":3,"MatchMinute":"5'","IdTeam":"C","IdPlayer":"c7","AwayGoals":1},
+	{"Type":34,"Period":5,"MatchMinute":"50'","IdTeam":"C","IdPlayer":"c3","HomeGoals":1,"AwayGoals":1},
+	{"Type":3,"Period":5,"MatchMinute":"70'","IdTeam":"C","IdPlayer":"c3","HomeGoals":1,"AwayGoals":1}
+]}`
+
+func TestSeasonStatsUpdate(t *testing.T) {
+	t.Parallel()
+	stub := &stubHTTPClient{responses: map[string]stubResponse{
+		"/calendar/matches":    {Body: statsCalendar},
+		"/timelines/17/s/g/m1": {Body: statsTimelineM1},
+		"/timelines/17/s/g/m2": {Status: http.StatusBadGateway},
+	}}
+	client := &fifa.Client{Client: stub}
+	stats := fifa.NewSeasonStats(client, &fifa.SeasonStatsOptions{CompetitionId: "17", SeasonId: "s", Workers: 2})
+
+	added, err := stats.Update()
+	assert.Equal(t, 1, added)
+	var apiErr *fifa.APIError
+	assert.True(t, errors.As(err, &apiErr), "expected per-match error, got: %v", err)
+
+	stub.mu.Lock()
+	stub.responses["/timelines/17/s/g/m2"] = stubResponse{Body: statsTimelineM2}
+	stub.mu.Unlock()
+	added, err = stats.Update()
+	if ok := assert.Nil(t, err, "expected no error with Update, got: %s", err); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, 1, added, "only the previously failed match should be fetched again")
+
+	scorers := stats.TopScorers(0)
+	if ok := assert.Len(t, scorers, 3); !ok {
+		t.FailNow()
+	}
+	assert.Equal(t, "a10", scorers[0].PlayerId, "tie on goals should be broken by assists")
+	assert.Equal(t, 1, scorers[0].Penalties)
+	assert.Equal(t, "a9", scorers[1].PlayerId)
+
+	assert.Equal(t, 1, stats.Player("c3").OwnGoals)
+	assert.Equal(t, 1, stats.Player("c3").RedCards)
+	assert.Equal(t, 1, stats.Player("b1").Saves)
+	assert.Equal(t, "B", stats.Player("b1").TeamId)
+	assert.Equal(t, 1, stats.Player("b4").YellowCards)
+
+	sheets := stats.TeamLeaderboard(fifa.TeamStatCleanSheets, 1)
+	if ok := assert.Len(t, sheets, 1); ok {
+		assert.Equal(t, "A", sheets[0].TeamId)
+	}
+	b := stats.Team("B")
+	assert.Equal(t, 2, b.Played)
+	assert.Equal(t, 3, b.GoalsAgainst)
+	reds := stats.TeamLeaderboard(fifa.TeamStatRedCards, 0)
+	assert.Equal(t, "C", reds[0].TeamId, "the most carded team should come first")
+	assert.Equal(t, 1, reds[0].RedCards)
+	yellows := stats.TeamLeaderboard(fifa.TeamStatYellowCards, 0)
+	assert.Equal(t, "B", yellows[0].TeamId)
+	conceded := stats.TeamLeaderboard(fifa.TeamStatGoalsAgainst, 0)
+	assert.Equal(t, "A", conceded[0].TeamId, "the fewest goals conceded should come first")
+}
+
+func TestSeasonStatsAddMatchOnce(t *testing.T) {
+	t.Parallel()
+	stats := fifa.NewSeasonStats(nil, nil)
+	m := &fifa.MatchResponse{Id: "m", Status: fifa.PLAYED}
+	events := &fifa.GetMatchEventsResponse{}
+	assert.True(t, stats.AddMatch(m, events))
+	assert.False(t, stats.AddMatch(m, events))
+}
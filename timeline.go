@@ -0,0 +1,327 @@
+package go_fifa
+
+import (
+	"sort"
+)
+
+const (
+	HomeSide = "home"
+	AwaySide = "away"
+)
+
+type CardCounts struct {
+	Yellow int
+	Red    int
+}
+
+type TimelineEntry struct {
+	Event  EventResponse
+	Minute MatchMinute
+	// Side is the side credited with the entry: the scoring side for goals
+	// (the opponent for own goals) and the offending side for cards.
+	Side string
+	// Disallowed is set on goals that were later ruled out, e.g. by VAR.
+	Disallowed bool
+	cancels    []int
+}
+
+func (e TimelineEntry) IsGoal() bool {
+	if e.Event.Period == SHOOTOUT {
+		return false
+	}
+	switch e.Event.Type {
+	case GoalScore, FreeKickGoal, PenaltyGoal, OwnGoal:
+		return true
+	}
+	return false
+}
+
+// Timeline is a match's events in playing order with enough context to
+// reconstruct the state of the match at any minute.
+type Timeline struct {
+	HomeTeamId string
+	AwayTeamId string
+	Entries    []TimelineEntry
+	starters   map[string][]string
+}
+
+type TimelineSnapshot struct {
+	Minute    MatchMinute
+	HomeScore int
+	AwayScore int
+	Cards     map[string]CardCounts
+	// OnPitch lists the players on the pitch per team id. It is only
+	// populated when line-ups were provided to NewTimeline.
+	OnPitch map[string][]string
+}
+
+// Leader returns the id of the leading team, or "" when the score is level.
+func (s TimelineSnapshot) Leader(homeTeamId string, awayTeamId string) string {
+	switch {
+	case s.HomeScore > s.AwayScore:
+		return homeTeamId
+	case s.AwayScore > s.HomeScore:
+		return awayTeamId
+	}
+	return ""
+}
+
+// NewTimeline orders the events of a match by period, minute and timestamp.
+// The home and away teams are optional; they provide team ids and starting
+// line-ups, and without them goal sides are inferred from the running score
+// carried on each event.
+func NewTimeline(events *GetMatchEventsResponse, home *TeamResponse, away *TeamResponse) *Timeline {
+	t := &Timeline{starters: map[string][]string{}}
+	for _, team := range []*TeamResponse{home, away} {
+		if team == nil {
+			continue
+		}
+		for _, p := range team.Players {
+			if p.Status == STARTING {
+				t.starters[team.Id] = append(t.starters[team.Id], p.Id)
+			}
+		}
+	}
+	if home != nil {
+		t.HomeTeamId = home.Id
+	}
+	if away != nil {
+		t.AwayTeamId = away.Id
+	}
+	for _, ev := range events.Events {
+		minute := ev.MatchMinute
+		if minute.Period == 0 {
+			minute.Period = ev.Period
+		}
+		t.Entries = append(t.Entries, TimelineEntry{Event: ev, Minute: minute})
+	}
+	sort.SliceStable(t.Entries, func(i, j int) bool {
+		a, b := t.Entries[i], t.Entries[j]
+		if c := a.Minute.Compare(b.Minute); c != 0 {
+			return c < 0
+		}
+		return a.Event.Timestamp.Before(b.Event.Timestamp)
+	})
+	t.resolveSides()
+	return t
+}
+
+func (t *Timeline) sideOf(teamId string) string {
+	switch {
+	case teamId == "":
+		return ""
+	case teamId == t.HomeTeamId:
+		return HomeSide
+	case teamId == t.AwayTeamId:
+		return AwaySide
+	}
+	return ""
+}
+
+func (t *Timeline) learnTeam(teamId string, side string) {
+	if teamId == "" {
+		return
+	}
+	if side == HomeSide && t.HomeTeamId == "" && teamId != t.AwayTeamId {
+		t.HomeTeamId = teamId
+	}
+	if side == AwaySide && t.AwayTeamId == "" && teamId != t.HomeTeamId {
+		t.AwayTeamId = teamId
+	}
+}
+
+func opposite(side string) string {
+	switch side {
+	case HomeSide:
+		return AwaySide
+	case AwaySide:
+		return HomeSide
+	}
+	return ""
+}
+
+// resolveSides attributes goals and cards to a side and rules out goals that
+// the running score on later events shows were cancelled.
+func (t *Timeline) resolveSides() {
+	var home, away int
+	lastGoal := map[string]int{}
+	for i := range t.Entries {
+		e := &t.Entries[i]
+		ev := e.Event
+		if e.IsGoal() {
+			side := t.sideOf(ev.TeamId)
+			if ev.Type == OwnGoal {
+				side = opposite(side)
+			}
+			if side == "" {
+				switch {
+				case ev.HomeGoals > home:
+					side = HomeSide
+				case ev.AwayGoals > away:
+					side = AwaySide
+				}
+				if ev.Type == OwnGoal {
+					t.learnTeam(ev.TeamId, opposite(side))
+				} else {
+					t.learnTeam(ev.TeamId, side)
+				}
+			}
+			e.Side = side
+			if side == HomeSide && ev.HomeGoals > 0 && ev.HomeGoals <= home {
+				t.disallowLast(lastGoal, HomeSide, i, &home, &away)
+			}
+			if side == AwaySide && ev.AwayGoals > 0 && ev.AwayGoals <= away {
+				t.disallowLast(lastGoal, AwaySide, i, &home, &away)
+			}
+			switch side {
+			case HomeSide:
+				home++
+			case AwaySide:
+				away++
+			}
+			lastGoal[side] = i
+			continue
+		}
+		e.Side = t.sideOf(ev.TeamId)
+		if isVAREvent(ev) {
+			if ev.HomeGoals < home {
+				t.disallowLast(lastGoal, HomeSide, i, &home, &away)
+			}
+			if ev.AwayGoals < away {
+				t.disallowLast(lastGoal, AwaySide, i, &home, &away)
+			}
+		}
+	}
+	for i := range t.Entries {
+		if t.Entries[i].Side == "" {
+			t.Entries[i].Side = t.sideOf(t.Entries[i].Event.TeamId)
+		}
+	}
+}
+
+// disallowLast rules out the last goal of a side, recording the entry at
+// which it was cancelled so earlier snapshots still include it.
+func (t *Timeline) disallowLast(lastGoal map[string]int, side string, by int, home *int, away *int) {
+	i, ok := lastGoal[side]
+	if !ok || t.Entries[i].Disallowed {
+		return
+	}
+	t.Entries[i].Disallowed = true
+	t.Entries[by].cancels = append(t.Entries[by].cancels, i)
+	delete(lastGoal, side)
+	if side == HomeSide {
+		*home--
+	} else {
+		*away--
+	}
+}
+
+func isVAREvent(ev EventResponse) bool {
+	return ev.VarDetail != "" || ev.VarNotificationData != (VarNotificationDataResponse{})
+}
+
+// At returns the state of the match after every entry up to and including
+// the given minute. Disallowed goals count until the entry that ruled them out.
+func (t *Timeline) At(minute MatchMinute) TimelineSnapshot {
+	snap := TimelineSnapshot{
+		Minute: minute,
+		Cards:  map[string]CardCounts{},
+	}
+	var onPitch map[string][]string
+	if len(t.starters) > 0 {
+		onPitch = map[string][]string{}
+		for team, players := range t.starters {
+			onPitch[team] = append([]string(nil), players...)
+		}
+	}
+	for _, e := range t.Entries {
+		if e.Minute.Compare(minute) > 0 {
+			break
+		}
+		ev := e.Event
+		for _, i := range e.cancels {
+			switch t.Entries[i].Side {
+			case HomeSide:
+				snap.HomeScore--
+			case AwaySide:
+				snap.AwayScore--
+			}
+		}
+		if e.IsGoal() {
+			switch e.Side {
+			case HomeSide:
+				snap.HomeScore++
+			case AwaySide:
+				snap.AwayScore++
+			}
+			continue
+		}
+		switch ev.Type {
+		case YellowCard:
+			c := snap.Cards[ev.TeamId]
+			c.Yellow++
+			snap.Cards[ev.TeamId] = c
+		case RedCard, DoubleYellow:
+			c := snap.Cards[ev.TeamId]
+			c.Red++
+			snap.Cards[ev.TeamId] = c
+			if onPitch != nil {
+				onPitch[ev.TeamId] = removePlayer(onPitch[ev.TeamId], ev.PlayerId)
+			}
+		case Substitution:
+			if onPitch != nil {
+				onPitch[ev.TeamId] = removePlayer(onPitch[ev.TeamId], ev.PlayerId)
+				if ev.SubPlayerId != "" {
+					onPitch[ev.TeamId] = append(onPitch[ev.TeamId], ev.SubPlayerId)
+				}
+			}
+		}
+	}
+	snap.OnPitch = onPitch
+	return snap
+}
+
+func removePlayer(players []string, id string) []string {
+	for i, p := range players {
+		if p == id {
+			return append(players[:i:i], players[i+1:]...)
+		}
+	}
+	return players
+}
+
+// ScoreAt returns the score after the given minute.
+func (t *Timeline) ScoreAt(minute MatchMinute) (int, int) {
+	snap := t.At(minute)
+	return snap.HomeScore, snap.AwayScore
+}
+
+// EndOf returns the state of the match at the end of a period, e.g. FIRST
+// for half-time.
+func (t *Timeline) EndOf(period PeriodEnum) TimelineSnapshot {
+	return t.At(MatchMinute{Minute: 1 << 16, Period: period})
+}
+
+// LeaderAt returns the id of the team leading after the given minute, or ""
+// when the score is level.
+func (t *Timeline) LeaderAt(minute MatchMinute) string {
+	return t.At(minute).Leader(t.HomeTeamId, t.AwayTeamId)
+}
+
+// ShootoutScore returns the penalty shootout score, which is kept separate
+// from the match score.
+func (t *Timeline) ShootoutScore() (int, int) {
+	var home, away int
+	for _, e := range t.Entries {
+		if e.Event.Period != SHOOTOUT {
+			continue
+		}
+		if e.Event.HomePenaltyGoals > home {
+			home = e.Event.HomePenaltyGoals
+		}
+		if e.Event.AwayPenaltyGoals > away {
+			away = e.Event.AwayPenaltyGoals
+		}
+	}
+	return home, away
+}
@@ -0,0 +1,95 @@
+package go_fifa_test
+
+import (
+	"testing"
+
+	fifa "github.com/ImDevinC/go-fifa"
+	"github.com/stretchr/testify/assert"
+)
+
+func minute(t *testing.T, s string) fifa.MatchMinute {
+	m, err := fifa.ParseMatchMinute(s)
+	if err != nil {
+		t.Fatalf("invalid minute %q: %s", s, err)
+	}
+	return m
+}
+
+func timelineEvent(t *testing.T, typ fifa.MatchEvent, period fifa.PeriodEnum, m string, team string, player string, home int, away int) fifa.EventResponse {
+	return fifa.EventResponse{
+		Type:        typ,
+		Period:      period,
+		MatchMinute: minute(t, m).WithPeriod(period),
+		TeamId:      team,
+		PlayerId:    player,
+		HomeGoals:   home,
+		AwayGoals:   away,
+	}
+}
+
+func timelineFixture(t *testing.T) *fifa.GetMatchEventsResponse {
+	sub := timelineEvent(t, fifa.Substitution, fifa.SECOND, "60'", "ARG", "a3", 2, 0)
+	sub.SubPlayerId = "a12"
+	varCheck := timelineEvent(t, fifa.VARPenalty, fifa.SECOND, "72'", "", "", 2, 0)
+	varCheck.VarDetail = "Goal cancelled"
+	return &fifa.GetMatchEventsResponse{Events: []fifa.EventResponse{
+		timelineEvent(t, fifa.GoalScore, fifa.SECOND, "70'", "ARG", "a1", 3, 0),
+		varCheck,
+		timelineEvent(t, fifa.PenaltyGoal, fifa.FIRST, "23'", "ARG", "a1", 1, 0),
+		timelineEvent(t, fifa.GoalScore, fifa.FIRST, "36'", "ARG", "a2", 2, 0),
+		timelineEvent(t, fifa.YellowCard, fifa.FIRST, "45'+2'", "FRA", "f1", 2, 0),
+		sub,
+		timelineEvent(t, fifa.PenaltyGoal, fifa.SECOND, "80'", "FRA", "f2", 2, 1),
+		timelineEvent(t, fifa.OwnGoal, fifa.SECOND, "81'", "ARG", "a2", 2, 2),
+		timelineEvent(t, fifa.RedCard, fifa.SECOND_EXTRA, "118'", "FRA", "f1", 2, 2),
+		timelineEvent(t, fifa.PenaltyGoal, fifa.SHOOTOUT, "120'", "FRA", "f2", 2, 2),
+	}}
+}
+
+func TestTimelineScoreAt(t *testing.T) {
+	t.Parallel()
+	home := &fifa.TeamResponse{Id: "ARG", Players: []fifa.MatchPlayerResponse{
+		{Id: "a1", Status: fifa.STARTING},
+		{Id: "a2", Status: fifa.STARTING},
+		{Id: "a3", Status: fifa.STARTING},
+		{Id: "a12", Status: fifa.SUBSTITUTE},
+	}}
+	away := &fifa.TeamResponse{Id: "FRA", Players: []fifa.MatchPlayerResponse{
+		{Id: "f1", Status: fifa.STARTING},
+		{Id: "f2", Status: fifa.STARTING},
+	}}
+	tl := fifa.NewTimeline(timelineFixture(t), home, away)
+
+	h, a := tl.ScoreAt(minute(t, "30'"))
+	assert.Equal(t, [2]int{1, 0}, [2]int{h, a})
+
+	ht := tl.EndOf(fifa.FIRST)
+	assert.Equal(t, [2]int{2, 0}, [2]int{ht.HomeScore, ht.AwayScore})
+	assert.Equal(t, "ARG", ht.Leader(tl.HomeTeamId, tl.AwayTeamId))
+	assert.Equal(t, fifa.CardCounts{Yellow: 1}, ht.Cards["FRA"])
+
+	h, a = tl.ScoreAt(minute(t, "71'"))
+	assert.Equal(t, [2]int{3, 0}, [2]int{h, a}, "goal counts until VAR rules it out")
+	h, a = tl.ScoreAt(minute(t, "75'"))
+	assert.Equal(t, [2]int{2, 0}, [2]int{h, a}, "goal should be disallowed after VAR")
+
+	snap := tl.At(minute(t, "85'"))
+	assert.Equal(t, [2]int{2, 2}, [2]int{snap.HomeScore, snap.AwayScore}, "own goal should count for the opponent")
+	assert.Equal(t, "", tl.LeaderAt(minute(t, "85'")))
+	assert.ElementsMatch(t, []string{"a1", "a2", "a12"}, snap.OnPitch["ARG"])
+
+	final := tl.EndOf(fifa.SHOOTOUT)
+	assert.Equal(t, [2]int{2, 2}, [2]int{final.HomeScore, final.AwayScore}, "shootout goals should not change the score")
+	assert.Equal(t, fifa.CardCounts{Yellow: 1, Red: 1}, final.Cards["FRA"])
+	assert.Equal(t, []string{"f2"}, final.OnPitch["FRA"])
+}
+
+func TestTimelineInfersSides(t *testing.T) {
+	t.Parallel()
+	tl := fifa.NewTimeline(timelineFixture(t), nil, nil)
+	assert.Equal(t, "ARG", tl.HomeTeamId)
+	assert.Equal(t, "FRA", tl.AwayTeamId)
+	snap := tl.EndOf(fifa.SECOND)
+	assert.Equal(t, [2]int{2, 2}, [2]int{snap.HomeScore, snap.AwayScore})
+	assert.Nil(t, snap.OnPitch)
+}
@@ -12,11 +12,42 @@ const (
 type PeriodEnum int
 
 const (
-	FIRST        PeriodEnum = 3
-	SECOND       PeriodEnum = 5
-	FIRST_EXTRA  PeriodEnum = 7
-	SECOND_EXTRA PeriodEnum = 9
-	SHOOTOUT     PeriodEnum = 11
+	FIRST           PeriodEnum = 3
+	HALF_TIME       PeriodEnum = 4
+	SECOND          PeriodEnum = 5
+	END_OF_SECOND   PeriodEnum = 6
+	FIRST_EXTRA     PeriodEnum = 7
+	EXTRA_HALF_TIME PeriodEnum = 8
+	SECOND_EXTRA    PeriodEnum = 9
+	END_OF_EXTRA    PeriodEnum = 10
+	SHOOTOUT        PeriodEnum = 11
+)
+
+type MatchStatus int
+
+const (
+	PLAYED       MatchStatus = 0
+	TO_BE_PLAYED MatchStatus = 1
+	LIVE         MatchStatus = 3
+	ABANDONED    MatchStatus = 4
+	POSTPONED    MatchStatus = 7
+	CANCELLED    MatchStatus = 8
+	LINEUPS      MatchStatus = 12
+)
+
+type PlayerStatus int
+
+const (
+	STARTING   PlayerStatus = 1
+	SUBSTITUTE PlayerStatus = 2
+)
+
+type CardType int
+
+const (
+	YELLOW     CardType = 1
+	RED        CardType = 2
+	YELLOW_RED CardType = 3
 )
 
 type MatchEvent int
@@ -78,6 +109,9 @@ type MatchResponse struct {
 	Stadium                   StadiumResponse              `json:"Stadium"`
 	ResultType                int                          `json:"ResultType"`
 	MatchDay                  string                       `json:"MatchDay"`
+	MatchNumber               int                          `json:"MatchNumber"`
+	PlaceHolderA              string                       `json:"PlaceHolderA"`
+	PlaceHolderB              string                       `json:"PlaceHolderB"`
 	HomeTeamPenaltyScore      int                          `json:"HomeTeamPenaltyScore"`
 	AwayTeamPenaltyScore      int                          `json:"AwayTeamPenaltyScore"`
 	AggregateHomeTeamScore    int                          `json:"AggregateHomeTeamScore"`
@@ -98,7 +132,7 @@ type MatchResponse struct {
 	TerritorialPossesion      string                       `json:"TerritorialPossesion"`
 	TerritorialThirdPossesion string                       `json:"TerritorialThirdPossesion"`
 	Officials                 []OfficialResponse           `json:"Officials"`
-	Status                    int                          `json:"MatchStatus"`
+	Status                    MatchStatus                  `json:"MatchStatus"`
 	GroupName                 []DefaultDescriptionResponse `json:"GroupName"`
 	StageName                 []DefaultDescriptionResponse `json:"StageName"`
 	OfficialityStatus         int                          `json:"OfficialityStatus"`
@@ -178,7 +212,7 @@ type MatchPlayerResponse struct {
 	Id            string                       `json:"IdPlayer"`
 	TeamId        string                       `json:"IdTeam"`
 	ShirtNumber   int                          `json:"ShirtNumber"`
-	Status        int                          `json:"Status"`        // TODO: Enum
+	Status        PlayerStatus                 `json:"Status"`
 	SpecialStatus *int                         `json:"SpecialStatus"` // TODO: Evaluate
 	IsCaptain     bool                         `json:"Captain"`
 	Name          []DefaultDescriptionResponse `json:"PlayerName"`
@@ -199,15 +233,15 @@ type CoachResponse struct {
 }
 
 type BookingResponse struct {
-	Card        int        `json:"Card"`
-	Period      PeriodEnum `json:"Period"`
-	EventId     string     `json:"IdEvent"`
-	EventNumber string     `json:"EventNumber"`
-	PlayerId    string     `json:"IdPlayer"`
-	CoachId     string     `json:"IdCoach"`
-	TeamId      string     `json:"IdTeam"`
-	Minute      string     `json:"Minute"`
-	Reason      string     `json:"Reason"`
+	Card        CardType    `json:"Card"`
+	Period      PeriodEnum  `json:"Period"`
+	EventId     string      `json:"IdEvent"`
+	EventNumber string      `json:"EventNumber"`
+	PlayerId    string      `json:"IdPlayer"`
+	CoachId     string      `json:"IdCoach"`
+	TeamId      string      `json:"IdTeam"`
+	Minute      MatchMinute `json:"Minute"`
+	Reason      string      `json:"Reason"`
 }
 
 type SubstitutionResponse struct {
@@ -219,7 +253,7 @@ type SubstitutionResponse struct {
 	PlayerOnId    string                       `json:"IdPlayerOn"`
 	PlayerOffName []DefaultDescriptionResponse `json:"PlayerOffName"`
 	PlayerOnName  []DefaultDescriptionResponse `json:"PlayerOnName"`
-	Minute        string                       `json:"Minute"`
+	Minute        MatchMinute                  `json:"Minute"`
 	TeamId        string                       `json:"TeamId"`
 }
 
@@ -233,13 +267,13 @@ type OfficialResponse struct {
 }
 
 type GoalResponse struct {
-	Id             string     `json:"IdGoal"`
-	TeamId         string     `json:"IdTeam"`
-	Type           int        `json:"Type"` // TODO: Enum
-	PlayerId       string     `json:"IdPlayer"`
-	Minute         string     `json:"Minute"`
-	AssistPlayerId string     `json:"IdAssistPlayer"`
-	Period         PeriodEnum `json:"Period"`
+	Id             string      `json:"IdGoal"`
+	TeamId         string      `json:"IdTeam"`
+	Type           int         `json:"Type"` // TODO: Enum
+	PlayerId       string      `json:"IdPlayer"`
+	Minute         MatchMinute `json:"Minute"`
+	AssistPlayerId string      `json:"IdAssistPlayer"`
+	Period         PeriodEnum  `json:"Period"`
 }
 
 type CompetitionResponse struct {
@@ -389,14 +423,14 @@ type MatchDataResponse struct {
 	FirstHalfExtraTime        int                          `json:"FirstHalfExtraTime"`
 	SecondHalfExtraTime       int                          `json:"SecondHalfExtraTime"`
 	Winner                    interface{}                  `json:"Winner"`
-	Period                    int                          `json:"Period"`
+	Period                    PeriodEnum                   `json:"Period"`
 	HomeTeam                  TeamResponse                 `json:"HomeTeam"`
 	AwayTeam                  TeamResponse                 `json:"AwayTeam"`
 	BallPossession            BallPossessionResponse       `json:"BallPossession"`
 	TerritorialPossesion      interface{}                  `json:"TerritorialPossesion"`
 	TerritorialThirdPossesion interface{}                  `json:"TerritorialThirdPossesion"`
 	Officials                 []OfficialResponse           `json:"Officials"`
-	MatchStatus               int                          `json:"MatchStatus"`
+	MatchStatus               MatchStatus                  `json:"MatchStatus"`
 	GroupName                 []interface{}                `json:"GroupName"`
 	StageName                 []DefaultDescriptionResponse `json:"StageName"`
 	OfficialityStatus         int                          `json:"OfficialityStatus"`